@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: MIT
+pragma solidity 0.8.24;
+
+import "../libs/LibAddress.sol";
+
+/// @title BridgeBatchProcessor
+/// @notice Sends several calls, such as a relayer's `processMessage` calls to the bridge, in a
+/// single transaction, then forwards the Ether received meanwhile, which is the processing fees
+/// the bridge pays to its caller, to the account which sent the batch.
+/// @dev It has the same interface as Multicall3's `aggregate3`. It never holds Ether between two
+/// transactions, so anyone can use it without trusting each other.
+/// @custom:security-contact security@taiko.xyz
+contract BridgeBatchProcessor {
+    using LibAddress for address;
+
+    struct Call3 {
+        address target;
+        bool allowFailure;
+        bytes callData;
+    }
+
+    struct Result {
+        bool success;
+        bytes returnData;
+    }
+
+    error BBP_CALL_FAILED();
+
+    /// @notice Sends the calls, then forwards this contract's balance to the sender.
+    /// @param _calls The calls to send, a failing call reverts the whole batch unless it allows
+    /// failure.
+    /// @return results_ The result of each call.
+    function aggregate3(Call3[] calldata _calls) external returns (Result[] memory results_) {
+        results_ = new Result[](_calls.length);
+
+        for (uint256 i; i < _calls.length; ++i) {
+            (results_[i].success, results_[i].returnData) =
+                _calls[i].target.call(_calls[i].callData);
+
+            if (!results_[i].success && !_calls[i].allowFailure) revert BBP_CALL_FAILED();
+        }
+
+        msg.sender.sendEtherAndVerify(address(this).balance);
+    }
+
+    /// @notice Receives the processing fees, kept cheap as the bridge caps the gas it sends
+    /// them with.
+    receive() external payable { }
+}
@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: MIT
+pragma solidity 0.8.24;
+
+import "../contracts/bridge/BridgeBatchProcessor.sol";
+import "../test/DeployCapability.sol";
+
+contract DeployBridgeBatchProcessor is DeployCapability {
+    modifier broadcast() {
+        vm.startBroadcast();
+        _;
+        vm.stopBroadcast();
+    }
+
+    function run() external broadcast {
+        // Run the script once on each chain messages are processed on, as follows
+        // `forge script --chain-id {CHAIN_ID} --rpc-url {YOUR_RPC_URL} --private-key=$PRIVATE_KEY
+        // --broadcast script/DeployBridgeBatchProcessor.s.sol:DeployBridgeBatchProcessor`
+
+        // The contract has no owner, storage or proxy, a single deployment is shared by any
+        // relayer on the chain.
+        address batchProcessor = address(new BridgeBatchProcessor());
+        console2.log("BridgeBatchProcessor address is:", batchProcessor);
+    }
+}
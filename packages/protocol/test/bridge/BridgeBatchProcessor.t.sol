@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: MIT
+pragma solidity 0.8.24;
+
+import "./Bridge2.t.sol";
+import "../../contracts/bridge/BridgeBatchProcessor.sol";
+
+contract BridgeBatchProcessorTest is BridgeTest2 {
+    function _message(uint64 _id) private view returns (IBridge.Message memory message_) {
+        message_.id = _id;
+        message_.destChainId = uint64(block.chainid);
+        message_.srcChainId = remoteChainId;
+        message_.gasLimit = 1_000_000;
+        message_.fee = 5_000_000;
+        message_.value = 1 ether;
+        message_.destOwner = Alice;
+        message_.to = David;
+    }
+
+    function _processMessageCall(IBridge.Message memory _msg)
+        private
+        view
+        returns (BridgeBatchProcessor.Call3 memory)
+    {
+        return BridgeBatchProcessor.Call3({
+            target: address(bridge),
+            allowFailure: true,
+            callData: abi.encodeCall(Bridge.processMessage, (_msg, fakeProof))
+        });
+    }
+
+    function test_bridgeBatchProcessor_forwards_fees_to_sender()
+        public
+        transactedBy(Carol)
+        assertSameTotalBalance
+    {
+        BridgeBatchProcessor processor = new BridgeBatchProcessor();
+
+        BridgeBatchProcessor.Call3[] memory calls = new BridgeBatchProcessor.Call3[](2);
+        calls[0] = _processMessageCall(_message(1));
+        calls[1] = _processMessageCall(_message(2));
+
+        uint256 carolBalance = Carol.balance;
+        uint256 davidBalance = David.balance;
+
+        BridgeBatchProcessor.Result[] memory results = processor.aggregate3(calls);
+
+        assertTrue(results[0].success);
+        assertTrue(results[1].success);
+        assertTrue(bridge.messageStatus(bridge.hashMessage(_message(1))) == IBridge.Status.DONE);
+        assertTrue(bridge.messageStatus(bridge.hashMessage(_message(2))) == IBridge.Status.DONE);
+        assertEq(David.balance, davidBalance + 2 ether);
+
+        // the fees the bridge paid to the processor are forwarded to the relayer.
+        assertTrue(Carol.balance > carolBalance);
+        assertEq(address(processor).balance, 0);
+    }
+
+    function test_bridgeBatchProcessor_allows_failure()
+        public
+        transactedBy(Carol)
+        assertSameTotalBalance
+    {
+        BridgeBatchProcessor processor = new BridgeBatchProcessor();
+
+        // the second call processes a message which has been processed already.
+        BridgeBatchProcessor.Call3[] memory calls = new BridgeBatchProcessor.Call3[](2);
+        calls[0] = _processMessageCall(_message(1));
+        calls[1] = _processMessageCall(_message(1));
+
+        BridgeBatchProcessor.Result[] memory results = processor.aggregate3(calls);
+
+        assertTrue(results[0].success);
+        assertFalse(results[1].success);
+
+        calls[0] = _processMessageCall(_message(2));
+        calls[1].allowFailure = false;
+
+        vm.expectRevert(BridgeBatchProcessor.BBP_CALL_FAILED.selector);
+        processor.aggregate3(calls);
+    }
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/cenkalti/backoff"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/labstack/echo/v4"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/signalservice"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/taikol2"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/http"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/repo"
@@ -46,29 +48,73 @@ func InitFromConfig(ctx context.Context, api *API, cfg *Config) (err error) {
 		return err
 	}
 
-	srcEthClient, err := ethclient.Dial(cfg.SrcRPCUrl)
+	suspendedTxRepository, err := repo.NewSuspendedTransactionRepository(db)
 	if err != nil {
 		return err
 	}
 
-	destEthClient, err := ethclient.Dial(cfg.DestRPCUrl)
+	srcRpcClient, err := cfg.DialRPCClientFunc(cfg.SrcRPCUrl)
 	if err != nil {
 		return err
 	}
 
+	srcEthClient := ethclient.NewClient(srcRpcClient)
+
+	destRpcClient, err := cfg.DialRPCClientFunc(cfg.DestRPCUrl)
+	if err != nil {
+		return err
+	}
+
+	destEthClient := ethclient.NewClient(destRpcClient)
+
 	taikoL2, err := taikol2.NewTaikoL2(cfg.DestTaikoAddress, destEthClient)
 	if err != nil {
 		return err
 	}
 
+	deadLetterRepository, err := repo.NewDeadLetterMessageRepository(db)
+	if err != nil {
+		return err
+	}
+
+	// messages sent from a chain can only be proven if its SignalService is configured.
+	var srcSignalService, destSignalService relayer.SignalService
+
+	if cfg.SrcSignalServiceAddress != relayer.ZeroAddress {
+		srcSignalService, err = signalservice.NewSignalService(cfg.SrcSignalServiceAddress, srcEthClient)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cfg.DestSignalServiceAddress != relayer.ZeroAddress {
+		destSignalService, err = signalservice.NewSignalService(cfg.DestSignalServiceAddress, destEthClient)
+		if err != nil {
+			return err
+		}
+	}
+
 	srv, err := http.NewServer(http.NewServerOpts{
-		EventRepo:               eventRepository,
-		Echo:                    echo.New(),
-		CorsOrigins:             cfg.CORSOrigins,
-		SrcEthClient:            srcEthClient,
-		DestEthClient:           destEthClient,
-		TaikoL2:                 taikoL2,
-		ProcessingFeeMultiplier: cfg.ProcessingFeeMultiplier,
+		EventRepo:                eventRepository,
+		SuspendedTxRepo:          suspendedTxRepository,
+		Echo:                     echo.New(),
+		CorsOrigins:              cfg.CORSOrigins,
+		SrcEthClient:             srcEthClient,
+		DestEthClient:            destEthClient,
+		TaikoL2:                  taikoL2,
+		ProcessingFeeMultiplier:  cfg.ProcessingFeeMultiplier,
+		AdminAPIKey:              cfg.AdminAPIKey,
+		FeeWindow:                cfg.FeeWindow,
+		FeePercentile:            cfg.FeePercentile,
+		FeeMinSamples:            cfg.FeeMinSamples,
+		DeadLetterRepo:           deadLetterRepository,
+		SrcSignalService:         srcSignalService,
+		SrcSignalServiceAddress:  cfg.SrcSignalServiceAddress,
+		SrcCaller:                srcRpcClient,
+		DestSignalService:        destSignalService,
+		DestSignalServiceAddress: cfg.DestSignalServiceAddress,
+		DestCaller:               destRpcClient,
+		StreamPollInterval:       cfg.StreamPollInterval,
 	})
 	if err != nil {
 		return err
@@ -102,6 +148,16 @@ func (api *API) Start() error {
 		}
 	}()
 
+	api.wg.Add(1)
+
+	go func() {
+		defer api.wg.Done()
+
+		if err := api.srv.RunEventStream(api.ctx); err != nil {
+			slog.Error("event stream", "error", err)
+		}
+	}()
+
 	go func() {
 		if err := backoff.Retry(func() error {
 			return utils.ScanBlocks(api.ctx, api.srcEthClient, &api.wg)
@@ -2,18 +2,20 @@ package api
 
 import (
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
 	"github.com/urfave/cli/v2"
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
 
 type Config struct {
 	// db configs
+	DatabaseDialect         string
 	DatabaseUsername        string
 	DatabasePassword        string
 	DatabaseName            string
@@ -23,32 +25,50 @@ type Config struct {
 	DatabaseMaxConnLifetime uint64
 	CORSOrigins             []string
 	// rpc configs
-	SrcRPCUrl               string
-	DestRPCUrl              string
-	ProcessingFeeMultiplier float64
-	DestTaikoAddress        common.Address
-	HTTPPort                uint64
-	OpenDBFunc              func() (db.DB, error)
+	SrcRPCUrl                string
+	DestRPCUrl               string
+	ProcessingFeeMultiplier  float64
+	DestTaikoAddress         common.Address
+	SrcSignalServiceAddress  common.Address
+	DestSignalServiceAddress common.Address
+	HTTPPort                 uint64
+	AdminAPIKey              string
+	StreamPollInterval       time.Duration
+	FeeWindow                time.Duration
+	FeePercentile            uint64
+	FeeMinSamples            uint64
+	OpenDBFunc               func() (db.DB, error)
+	DialRPCClientFunc        func(url string) (*rpc.Client, error)
 }
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
 func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 	return &Config{
-		DatabaseUsername:        c.String(flags.DatabaseUsername.Name),
-		DatabasePassword:        c.String(flags.DatabasePassword.Name),
-		DatabaseName:            c.String(flags.DatabaseName.Name),
-		DatabaseHost:            c.String(flags.DatabaseHost.Name),
-		DatabaseMaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
-		DatabaseMaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
-		DatabaseMaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
-		CORSOrigins:             strings.Split(c.String(flags.CORSOrigins.Name), ","),
-		HTTPPort:                c.Uint64(flags.HTTPPort.Name),
-		SrcRPCUrl:               c.String(flags.SrcRPCUrl.Name),
-		DestRPCUrl:              c.String(flags.DestRPCUrl.Name),
-		ProcessingFeeMultiplier: c.Float64(flags.ProcessingFeeMultiplier.Name),
-		DestTaikoAddress:        common.HexToAddress(c.String(flags.DestTaikoAddress.Name)),
+		DatabaseDialect:          c.String(flags.DatabaseDialect.Name),
+		DatabaseUsername:         c.String(flags.DatabaseUsername.Name),
+		DatabasePassword:         c.String(flags.DatabasePassword.Name),
+		DatabaseName:             c.String(flags.DatabaseName.Name),
+		DatabaseHost:             c.String(flags.DatabaseHost.Name),
+		DatabaseMaxIdleConns:     c.Uint64(flags.DatabaseMaxIdleConns.Name),
+		DatabaseMaxOpenConns:     c.Uint64(flags.DatabaseMaxOpenConns.Name),
+		DatabaseMaxConnLifetime:  c.Uint64(flags.DatabaseConnMaxLifetime.Name),
+		CORSOrigins:              strings.Split(c.String(flags.CORSOrigins.Name), ","),
+		HTTPPort:                 c.Uint64(flags.HTTPPort.Name),
+		AdminAPIKey:              c.String(flags.AdminAPIKey.Name),
+		StreamPollInterval:       c.Duration(flags.StreamPollInterval.Name),
+		SrcRPCUrl:                c.String(flags.SrcRPCUrl.Name),
+		DestRPCUrl:               c.String(flags.DestRPCUrl.Name),
+		ProcessingFeeMultiplier:  c.Float64(flags.ProcessingFeeMultiplier.Name),
+		DestTaikoAddress:         common.HexToAddress(c.String(flags.DestTaikoAddress.Name)),
+		SrcSignalServiceAddress:  common.HexToAddress(c.String(flags.SrcSignalServiceAddress.Name)),
+		DestSignalServiceAddress: common.HexToAddress(c.String(flags.DestSignalServiceAddress.Name)),
+		FeeWindow:                c.Duration(flags.FeeWindow.Name),
+		FeePercentile:            c.Uint64(flags.FeePercentile.Name),
+		FeeMinSamples:            c.Uint64(flags.FeeMinSamples.Name),
+		DialRPCClientFunc:        rpc.Dial,
 		OpenDBFunc: func() (db.DB, error) {
 			return db.OpenDBConnection(db.DBConnectionOpts{
+				Dialect:         db.Dialect(c.String(flags.DatabaseDialect.Name)),
 				Name:            c.String(flags.DatabaseUsername.Name),
 				Password:        c.String(flags.DatabasePassword.Name),
 				Database:        c.String(flags.DatabaseName.Name),
@@ -56,8 +76,8 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 				MaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
 				MaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
 				MaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
-				OpenFunc: func(dsn string) (db.DB, error) {
-					gormDB, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+				OpenFunc: func(dialector gorm.Dialector) (db.DB, error) {
+					gormDB, err := gorm.Open(dialector, &gorm.Config{
 						Logger: logger.Default.LogMode(logger.Silent),
 					})
 					if err != nil {
@@ -2,6 +2,7 @@ package api
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli/v2"
@@ -12,11 +13,17 @@ import (
 )
 
 var (
-	databaseMaxIdleConns    = "10"
-	databaseMaxOpenConns    = "10"
-	databaseMaxConnLifetime = "30"
-	HTTPPort                = "1000"
-	destTaikoAddress        = "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD377"
+	databaseMaxIdleConns     = "10"
+	databaseMaxOpenConns     = "10"
+	databaseMaxConnLifetime  = "30"
+	HTTPPort                 = "1000"
+	destTaikoAddress         = "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD377"
+	srcSignalServiceAddress  = "0x63fAC9201494f0Bd17b9892b9faE4D52Fe3Bd378"
+	destSignalServiceAddress = "0x63Fac9201494f0bD17b9892b9fAe4D52Fe3bD379"
+	adminAPIKey              = "adminApiKey"
+	feeWindow                = "12h"
+	feePercentile            = "95"
+	feeMinSamples            = "50"
 )
 
 func setupApp() *cli.App {
@@ -36,6 +43,7 @@ func TestNewConfigFromCliContext(t *testing.T) {
 	app.Action = func(ctx *cli.Context) error {
 		c, err := NewConfigFromCliContext(ctx)
 		assert.Nil(t, err)
+		assert.Equal(t, "mysql", c.DatabaseDialect)
 		assert.Equal(t, "dbuser", c.DatabaseUsername)
 		assert.Equal(t, "dbpass", c.DatabasePassword)
 		assert.Equal(t, "dbname", c.DatabaseName)
@@ -48,6 +56,13 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		assert.Equal(t, "srcRpcUrl", c.SrcRPCUrl)
 		assert.Equal(t, "destRpcUrl", c.DestRPCUrl)
 		assert.Equal(t, destTaikoAddress, c.DestTaikoAddress.Hex())
+		assert.Equal(t, srcSignalServiceAddress, c.SrcSignalServiceAddress.Hex())
+		assert.Equal(t, destSignalServiceAddress, c.DestSignalServiceAddress.Hex())
+		assert.Equal(t, adminAPIKey, c.AdminAPIKey)
+		assert.Equal(t, 2*time.Second, c.StreamPollInterval)
+		assert.Equal(t, 12*time.Hour, c.FeeWindow)
+		assert.Equal(t, uint64(95), c.FeePercentile)
+		assert.Equal(t, uint64(50), c.FeeMinSamples)
 
 		c.OpenDBFunc = func() (db.DB, error) {
 			return &mock.DB{}, nil
@@ -72,5 +87,12 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		"--" + flags.SrcRPCUrl.Name, "srcRpcUrl",
 		"--" + flags.DestRPCUrl.Name, "destRpcUrl",
 		"--" + flags.DestTaikoAddress.Name, destTaikoAddress,
+		"--" + flags.SrcSignalServiceAddress.Name, srcSignalServiceAddress,
+		"--" + flags.DestSignalServiceAddress.Name, destSignalServiceAddress,
+		"--" + flags.AdminAPIKey.Name, adminAPIKey,
+		"--" + flags.StreamPollInterval.Name, "2s",
+		"--" + flags.FeeWindow.Name, feeWindow,
+		"--" + flags.FeePercentile.Name, feePercentile,
+		"--" + flags.FeeMinSamples.Name, feeMinSamples,
 	}))
 }
@@ -1,6 +1,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/urfave/cli/v2"
 )
 
@@ -27,6 +29,40 @@ var (
 		Value:    2.5,
 		EnvVars:  []string{"PROCESSING_FEE_MULTIPLIER"},
 	}
+	AdminAPIKey = &cli.StringFlag{
+		Name:     "http.adminApiKey",
+		Usage:    "API key required to suspend and unsuspend messages, admin endpoints are disabled if not set",
+		Category: indexerCategory,
+		EnvVars:  []string{"HTTP_ADMIN_API_KEY"},
+	}
+	StreamPollInterval = &cli.DurationFlag{
+		Name:     "http.streamPollInterval",
+		Usage:    "How often new events are read to push to the event stream, 0 to disable the event stream",
+		Category: indexerCategory,
+		Value:    time.Second,
+		EnvVars:  []string{"HTTP_STREAM_POLL_INTERVAL"},
+	}
+	FeeWindow = &cli.DurationFlag{
+		Name:     "fees.window",
+		Usage:    "How far back processed messages are sampled to recommend gas limits, 0 to use the static gas limits",
+		Category: indexerCategory,
+		Value:    24 * time.Hour,
+		EnvVars:  []string{"FEES_WINDOW"},
+	}
+	FeePercentile = &cli.Uint64Flag{
+		Name:     "fees.percentile",
+		Usage:    "Percentile of the gas used by sampled messages which, plus 20% headroom, is recommended as the gas limit",
+		Category: indexerCategory,
+		Value:    90,
+		EnvVars:  []string{"FEES_PERCENTILE"},
+	}
+	FeeMinSamples = &cli.Uint64Flag{
+		Name:     "fees.minSamples",
+		Usage:    "Minimum number of sampled messages of a type to recommend a gas limit from instead of the static one",
+		Category: indexerCategory,
+		Value:    20,
+		EnvVars:  []string{"FEES_MIN_SAMPLES"},
+	}
 )
 
 var APIFlags = MergeFlags(CommonFlags, []cli.Flag{
@@ -35,4 +71,10 @@ var APIFlags = MergeFlags(CommonFlags, []cli.Flag{
 	CORSOrigins,
 	ProcessingFeeMultiplier,
 	DestTaikoAddress,
+	AdminAPIKey,
+	StreamPollInterval,
+	FeeWindow,
+	FeePercentile,
+	FeeMinSamples,
+	DestSignalServiceAddress,
 })
@@ -11,6 +11,7 @@ var (
 	watchdogCategory  = "WATCHDOG"
 	bridgeCategory    = "BRIDGE"
 	txmgrCategory     = "TX_MANAGER"
+	replayCategory    = "REPLAY"
 )
 
 var (
@@ -59,6 +60,13 @@ var (
 )
 
 var (
+	DatabaseDialect = &cli.StringFlag{
+		Name:     "db.dialect",
+		Usage:    "Database engine, mysql or postgres",
+		Value:    "mysql",
+		Category: commonCategory,
+		EnvVars:  []string{"DATABASE_DIALECT"},
+	}
 	DatabaseMaxIdleConns = &cli.Uint64Flag{
 		Name:     "db.maxIdleConns",
 		Usage:    "Database max idle connections",
@@ -126,6 +134,7 @@ var CommonFlags = []cli.Flag{
 	SrcRPCUrl,
 	DestRPCUrl,
 	// optional
+	DatabaseDialect,
 	DatabaseMaxIdleConns,
 	DatabaseConnMaxLifetime,
 	DatabaseMaxOpenConns,
@@ -136,11 +145,24 @@ var CommonFlags = []cli.Flag{
 	BackOffRetryInterval,
 }
 
-// MergeFlags merges the given flag slices.
+// MergeFlags merges the given flag slices, keeping only the first flag with a given name,
+// so flag sets which share common flags can be combined.
 func MergeFlags(groups ...[]cli.Flag) []cli.Flag {
 	var merged []cli.Flag
+
+	seen := make(map[string]struct{})
+
 	for _, group := range groups {
-		merged = append(merged, group...)
+		for _, f := range group {
+			name := f.Names()[0]
+			if _, ok := seen[name]; ok {
+				continue
+			}
+
+			seen[name] = struct{}{}
+
+			merged = append(merged, f)
+		}
 	}
 
 	return merged
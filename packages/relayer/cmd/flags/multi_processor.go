@@ -0,0 +1,52 @@
+package flags
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	RoutesFile = &cli.StringFlag{
+		Name:     "routesFile",
+		Usage:    "Path to a JSON file listing the routes to process, each with its source and destination chain and hops",
+		Required: true,
+		Category: processorCategory,
+		EnvVars:  []string{"ROUTES_FILE"},
+	}
+)
+
+// MultiProcessorFlags are the processor flags which are shared by every route. The
+// chain specific ones, such as RPC URLs and contract addresses, are set per route
+// in the routes file instead.
+var MultiProcessorFlags = MergeFlags([]cli.Flag{
+	// required
+	DatabaseUsername,
+	DatabasePassword,
+	DatabaseHost,
+	DatabaseName,
+	// optional
+	DatabaseDialect,
+	DatabaseMaxIdleConns,
+	DatabaseConnMaxLifetime,
+	DatabaseMaxOpenConns,
+	MetricsHTTPPort,
+	ETHClientTimeout,
+	BackOffMaxRetrys,
+	BackOffRetryInterval,
+}, QueueFlags, TxmgrFlags, []cli.Flag{
+	ProcessorPrivateKey,
+	RoutesFile,
+	// optional
+	HeaderSyncInterval,
+	Confirmations,
+	ConfirmationTimeout,
+	ProfitableOnly,
+	ProfitMargin,
+	QueuePrefetchCount,
+	CacheOption,
+	UnprofitableMessageQueueExpiration,
+	MaxMessageRetries,
+	MinFeeToProcess,
+	BatchWindow,
+	BatchMaxSize,
+	SponsorshipPoliciesFile,
+})
@@ -72,6 +72,20 @@ var (
 		Category: processorCategory,
 		EnvVars:  []string{"PROFITABLE_ONLY"},
 	}
+	ProfitMargin = &cli.Uint64Flag{
+		Name:     "profitMargin",
+		Usage:    "Percentage the processing fee must exceed the estimated cost by, when profitableOnly is set",
+		Value:    0,
+		Category: processorCategory,
+		EnvVars:  []string{"PROFIT_MARGIN"},
+	}
+	L1FeeOracleAddress = &cli.StringFlag{
+		Name:     "l1FeeOracleAddress",
+		Usage:    "GasPriceOracle address on the destination chain, if it charges an L1 data fee for transactions",
+		Category: processorCategory,
+		Required: false,
+		EnvVars:  []string{"L1_FEE_ORACLE_ADDRESS"},
+	}
 	QueuePrefetchCount = &cli.Uint64Flag{
 		Name:     "queue.prefetch",
 		Usage:    "How many messages to prefetch",
@@ -120,18 +134,18 @@ var (
 		Category: processorCategory,
 		Required: false,
 		EnvVars:  []string{"CACHE_OPTION"},
-		Value:    3,
+		Value:    0,
 	}
 	UnprofitableMessageQueueExpiration = &cli.StringFlag{
 		Name:     "unprofitableMessageQueueExpiration",
-		Usage:    "Time in seconds for queue message to expire when unprofitable, which will re-route it to be checked again",
+		Usage:    "Time in milliseconds for a queue message to expire when unprofitable, suspended or failed, which will re-route it to be checked again. Defaults to one minute",
 		Category: processorCategory,
 		Required: false,
 		EnvVars:  []string{"UNPROFITABLE_MESSAGE_QUEUE_EXPIRATION"},
 	}
 	MaxMessageRetries = &cli.Uint64Flag{
 		Name:     "maxMessageRetries",
-		Usage:    "How many times to retry a message due to unprofitability",
+		Usage:    "How many times to retry a message which is unprofitable or fails to be processed, before it is dead-lettered. Transient errors do not count",
 		Category: processorCategory,
 		Value:    5,
 		EnvVars:  []string{"MAX_MESSAGE_RETRIES"},
@@ -143,6 +157,13 @@ var (
 		Required: false,
 		EnvVars:  []string{"DEST_QUOTA_MANAGER_ADDRESS"},
 	}
+	DestSignalServiceAddress = &cli.StringFlag{
+		Name:     "destSignalServiceAddress",
+		Usage:    "SignalService address for the destination chain, to use its cached roots and prove messages sent from it",
+		Category: processorCategory,
+		Required: false,
+		EnvVars:  []string{"DEST_SIGNAL_SERVICE_ADDRESS"},
+	}
 	MinFeeToProcess = &cli.Uint64Flag{
 		Name:     "minFeeToProcess",
 		Usage:    "Minimum fee to process",
@@ -150,6 +171,34 @@ var (
 		Value:    0,
 		EnvVars:  []string{"MIN_FEE_TO_PROCESS"},
 	}
+	BatchWindow = &cli.DurationFlag{
+		Name:     "batch.window",
+		Usage:    "How long to buffer messages to process them together in one transaction, 0 to disable batching",
+		Category: processorCategory,
+		Value:    0,
+		EnvVars:  []string{"BATCH_WINDOW"},
+	}
+	BatchMaxSize = &cli.Uint64Flag{
+		Name:     "batch.maxSize",
+		Usage:    "Most messages processed in one batched transaction",
+		Category: processorCategory,
+		Value:    20,
+		EnvVars:  []string{"BATCH_MAX_SIZE"},
+	}
+	DestBatchProcessorAddress = &cli.StringFlag{
+		Name:     "destBatchProcessorAddress",
+		Usage:    "BridgeBatchProcessor contract on the destination chain to process batched messages through, which forwards their fees to the relayer",
+		Category: processorCategory,
+		Required: false,
+		EnvVars:  []string{"DEST_BATCH_PROCESSOR_ADDRESS"},
+	}
+	SponsorshipPoliciesFile = &cli.StringFlag{
+		Name:     "sponsorshipPoliciesFile",
+		Usage:    "Path to a JSON file listing the policies of messages to process regardless of their fee",
+		Category: processorCategory,
+		Required: false,
+		EnvVars:  []string{"SPONSORSHIP_POLICIES_FILE"},
+	}
 )
 
 var ProcessorFlags = MergeFlags(CommonFlags, QueueFlags, TxmgrFlags, []cli.Flag{
@@ -163,6 +212,8 @@ var ProcessorFlags = MergeFlags(CommonFlags, QueueFlags, TxmgrFlags, []cli.Flag{
 	Confirmations,
 	ConfirmationTimeout,
 	ProfitableOnly,
+	ProfitMargin,
+	L1FeeOracleAddress,
 	QueuePrefetchCount,
 	EnableTaikoL2,
 	HopRPCUrls,
@@ -175,4 +226,9 @@ var ProcessorFlags = MergeFlags(CommonFlags, QueueFlags, TxmgrFlags, []cli.Flag{
 	MaxMessageRetries,
 	MinFeeToProcess,
 	DestQuotaManagerAddress,
+	DestSignalServiceAddress,
+	BatchWindow,
+	BatchMaxSize,
+	DestBatchProcessorAddress,
+	SponsorshipPoliciesFile,
 })
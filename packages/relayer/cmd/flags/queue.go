@@ -1,39 +1,56 @@
 package flags
 
-import "github.com/urfave/cli/v2"
+import (
+	"fmt"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+	"github.com/urfave/cli/v2"
+)
 
 var (
+	QueueType = &cli.StringFlag{
+		Name:     "queue.type",
+		Usage:    fmt.Sprintf("Queue backend to use, one of %v", queue.Types),
+		Value:    string(queue.RabbitMQ),
+		Category: commonCategory,
+		EnvVars:  []string{"QUEUE_TYPE"},
+	}
+	QueueDataDir = &cli.StringFlag{
+		Name:     "queue.dataDir",
+		Usage:    "Directory the inprocess queue persists messages to",
+		Value:    "relayer-queue",
+		Category: commonCategory,
+		EnvVars:  []string{"QUEUE_DATA_DIR"},
+	}
 	QueueUsername = &cli.StringFlag{
 		Name:     "queue.username",
-		Usage:    "Queue connection username",
-		Required: true,
+		Usage:    "Queue connection username, required for rabbitmq",
 		Category: commonCategory,
 		EnvVars:  []string{"QUEUE_USER"},
 	}
 	QueuePassword = &cli.StringFlag{
 		Name:     "queue.password",
-		Usage:    "Queue connection password",
-		Required: true,
+		Usage:    "Queue connection password, required for rabbitmq",
 		Category: commonCategory,
 		EnvVars:  []string{"QUEUE_PASSWORD"},
 	}
 	QueueHost = &cli.StringFlag{
 		Name:     "queue.host",
-		Usage:    "Queue connection host",
-		Required: true,
+		Usage:    "Queue connection host, required for rabbitmq",
 		Category: commonCategory,
 		EnvVars:  []string{"QUEUE_HOST"},
 	}
 	QueuePort = &cli.Uint64Flag{
 		Name:     "queue.port",
-		Usage:    "Queue connection port",
-		Required: true,
+		Usage:    "Queue connection port, required for rabbitmq",
 		Category: commonCategory,
 		EnvVars:  []string{"QUEUE_PORT"},
 	}
 )
 
 var QueueFlags = []cli.Flag{
+	QueueType,
+	QueueDataDir,
 	QueueUsername,
 	QueuePassword,
 	QueueHost,
@@ -0,0 +1,50 @@
+package flags
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	ReplayMsgHash = &cli.StringFlag{
+		Name:     "replay.msgHash",
+		Usage:    "Only replay the dead-lettered message with this msgHash",
+		Category: replayCategory,
+		EnvVars:  []string{"REPLAY_MSG_HASH"},
+	}
+	ReplaySrcChainID = &cli.Uint64Flag{
+		Name:     "replay.srcChainId",
+		Usage:    "Only replay dead-lettered messages sent from this source chain",
+		Category: replayCategory,
+		EnvVars:  []string{"REPLAY_SRC_CHAIN_ID"},
+	}
+	ReplaySince = &cli.StringFlag{
+		Name:     "replay.since",
+		Usage:    "Only replay messages dead-lettered at or after this RFC3339 time",
+		Category: replayCategory,
+		EnvVars:  []string{"REPLAY_SINCE"},
+	}
+	ReplayUntil = &cli.StringFlag{
+		Name:     "replay.until",
+		Usage:    "Only replay messages dead-lettered at or before this RFC3339 time",
+		Category: replayCategory,
+		EnvVars:  []string{"REPLAY_UNTIL"},
+	}
+)
+
+var ReplayFlags = MergeFlags([]cli.Flag{
+	// required
+	DatabaseUsername,
+	DatabasePassword,
+	DatabaseHost,
+	DatabaseName,
+	// optional
+	DatabaseDialect,
+	DatabaseMaxIdleConns,
+	DatabaseConnMaxLifetime,
+	DatabaseMaxOpenConns,
+}, QueueFlags, []cli.Flag{
+	ReplayMsgHash,
+	ReplaySrcChainID,
+	ReplaySince,
+	ReplayUntil,
+})
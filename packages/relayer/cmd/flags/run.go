@@ -0,0 +1,5 @@
+package flags
+
+// RunFlags are the flags of the indexer, processor and API combined, which
+// share their common, database and queue flags.
+var RunFlags = MergeFlags(IndexerFlags, ProcessorFlags, APIFlags)
@@ -14,6 +14,17 @@ var (
 	}
 )
 
+// optional
+var (
+	PauseBridgesOnForgedMessages = &cli.BoolFlag{
+		Name:     "pauseBridgesOnForgedMessages",
+		Usage:    "Whether to also pause both bridges on a forged message, instead of only suspending the message",
+		Value:    false,
+		Category: watchdogCategory,
+		EnvVars:  []string{"PAUSE_BRIDGES_ON_FORGED_MESSAGES"},
+	}
+)
+
 var WatchdogFlags = MergeFlags(CommonFlags, QueueFlags, TxmgrFlags, []cli.Flag{
 	WatchdogPrivateKey,
 	// optional
@@ -22,4 +33,5 @@ var WatchdogFlags = MergeFlags(CommonFlags, QueueFlags, TxmgrFlags, []cli.Flag{
 	QueuePrefetchCount,
 	DestBridgeAddress,
 	SrcBridgeAddress,
+	PauseBridgesOnForgedMessages,
 })
@@ -12,6 +12,8 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/utils"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/indexer"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/processor"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/replay"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/run"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/watchdog"
 	"github.com/urfave/cli/v2"
 )
@@ -60,6 +62,13 @@ func main() {
 			Description: "Taiko relayer processor software",
 			Action:      utils.SubcommandAction(new(processor.Processor)),
 		},
+		{
+			Name:        "multiprocessor",
+			Flags:       flags.MultiProcessorFlags,
+			Usage:       "Starts a processor for each route in a routes file",
+			Description: "Taiko relayer processor software for many chain pairs, in a single process",
+			Action:      utils.SubcommandAction(new(processor.MultiProcessor)),
+		},
 		{
 			Name:        "watchdog",
 			Flags:       flags.WatchdogFlags,
@@ -74,6 +83,20 @@ func main() {
 			Description: "Taiko relayer bridge software",
 			Action:      utils.SubcommandAction(new(bridge.Bridge)),
 		},
+		{
+			Name:        "run",
+			Flags:       flags.RunFlags,
+			Usage:       "Starts the indexer, processor and http API together",
+			Description: "Taiko relayer indexer, processor and http API software for one chain pair, in a single process",
+			Action:      utils.SubcommandAction(new(run.Run)),
+		},
+		{
+			Name:        "replay",
+			Flags:       flags.ReplayFlags,
+			Usage:       "Re-enqueues dead-lettered messages",
+			Description: "Taiko relayer replay software, which puts messages that reached maxMessageRetries back onto the processor queue",
+			Action:      utils.OneShotAction(new(replay.Replay)),
+		},
 	}
 
 	if err := app.Run(os.Args); err != nil {
@@ -61,3 +61,30 @@ func SubcommandAction(app SubcommandApplication) cli.ActionFunc {
 		return nil
 	}
 }
+
+// OneShotAction runs an application which does its work in Start and then exits,
+// rather than running until it receives a signal.
+func OneShotAction(app SubcommandApplication) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		ctx, ctxClose := context.WithCancel(context.Background())
+		defer func() { ctxClose() }()
+
+		if err := app.InitFromCli(ctx, c); err != nil {
+			return err
+		}
+
+		defer func() {
+			app.Close(ctx)
+			slog.Info("Application stopped", "name", app.Name())
+		}()
+
+		slog.Info("Starting Taiko relayer application", "name", app.Name())
+
+		if err := app.Start(); err != nil {
+			slog.Error("Starting application error", "name", app.Name(), "error", err)
+			return err
+		}
+
+		return nil
+	}
+}
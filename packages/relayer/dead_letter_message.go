@@ -0,0 +1,54 @@
+package relayer
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// DeadLetterMessage is a queue message which the processor gave up on after it reached
+// maxMessageRetries. The original queue message body is kept, so it can be replayed.
+type DeadLetterMessage struct {
+	ID           int            `json:"id"`
+	MessageID    int            `json:"messageID"`
+	SrcChainID   int64          `json:"srcChainID"`
+	DestChainID  int64          `json:"destChainID"`
+	MsgHash      string         `json:"msgHash"`
+	TimesRetried uint64         `json:"timesRetried"`
+	LastError    string         `json:"lastError"`
+	Body         datatypes.JSON `json:"body"`
+	Replayed     bool           `json:"replayed"`
+	ReplayedAt   *time.Time     `json:"replayedAt"`
+	CreatedAt    time.Time      `json:"createdAt"`
+	UpdatedAt    time.Time      `json:"updatedAt"`
+}
+
+// SaveDeadLetterMessageOpts
+type SaveDeadLetterMessageOpts struct {
+	MessageID    int
+	SrcChainID   int64
+	DestChainID  int64
+	MsgHash      string
+	TimesRetried uint64
+	LastError    string
+	Body         []byte
+}
+
+// FindDeadLetterMessagesOpts filters dead-lettered messages. Every field is optional,
+// and messages which have already been replayed are only returned if IncludeReplayed
+// is set.
+type FindDeadLetterMessagesOpts struct {
+	MsgHash         *string
+	SrcChainID      *int64
+	Since           *time.Time
+	Until           *time.Time
+	IncludeReplayed bool
+}
+
+// DeadLetterMessageRepository is used to interact with dead-lettered messages in the store
+type DeadLetterMessageRepository interface {
+	Save(ctx context.Context, opts SaveDeadLetterMessageOpts) (*DeadLetterMessage, error)
+	Find(ctx context.Context, opts FindDeadLetterMessagesOpts) ([]*DeadLetterMessage, error)
+	MarkReplayed(ctx context.Context, id int) error
+}
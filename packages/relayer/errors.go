@@ -11,6 +11,10 @@ var (
 		"ERR_NO_BLOCK_REPOSITORY",
 		"BlockRepository is required",
 	)
+	ErrNoSuspendedTransactionRepository = errors.Validation.NewWithKeyAndDetail(
+		"ERR_NO_SUSPENDED_TRANSACTION_REPOSITORY",
+		"SuspendedTransactionRepository is required",
+	)
 	ErrNoCORSOrigins = errors.Validation.NewWithKeyAndDetail("ERR_NO_CORS_ORIGINS", "CORS Origins are required")
 	ErrNoProver      = errors.Validation.NewWithKeyAndDetail("ERR_NO_PROVER", "Prover is required")
 	ErrNoRPCClient   = errors.Validation.NewWithKeyAndDetail("ERR_NO_RPC_CLIENT", "RPCClient is required")
@@ -27,4 +31,5 @@ var (
 	)
 	ErrInvalidMode  = errors.Validation.NewWithKeyAndDetail("ERR_INVALID_MODE", "Mode not supported")
 	ErrUnprofitable = errors.Validation.NewWithKeyAndDetail("ERR_UNPROFITABLE", "Transaction is unprofitable to process")
+	ErrSuspended    = errors.Validation.NewWithKeyAndDetail("ERR_SUSPENDED", "Message has been suspended")
 )
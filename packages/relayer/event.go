@@ -82,6 +82,8 @@ type Event struct {
 	IsProfitable            *bool          `json:"isProfitable"`
 	EstimatedOnchainFee     *uint64        `json:"estimatedOnchainFee"`
 	IsProfitableEvaluatedAt *time.Time     `json:"isProfitableEvaluatedAt"`
+	ProcessingGasUsed       *uint64        `json:"processingGasUsed"`
+	ProcessedAt             *time.Time     `json:"processedAt"`
 }
 
 // SaveEventOpts
@@ -118,6 +120,13 @@ type UpdateFeesAndProfitabilityOpts struct {
 	IsProfitableEvaluatedAt time.Time
 }
 
+// UpdateProcessingGasUsedOpts is the gas the relayer's processMessage transaction used,
+// read from its receipt.
+type UpdateProcessingGasUsedOpts struct {
+	GasUsed     uint64
+	ProcessedAt time.Time
+}
+
 type FindAllByAddressOpts struct {
 	Address   common.Address
 	EventType *EventType
@@ -126,12 +135,46 @@ type FindAllByAddressOpts struct {
 	ChainID   *big.Int
 }
 
+type FindProcessingCostsOpts struct {
+	DestChainID uint64
+	// Since excludes messages processed before it.
+	Since time.Time
+}
+
+// ProcessingCost is the gas a MessageSent event used to be processed on its
+// destination chain.
+type ProcessingCost struct {
+	EventType EventType
+	// Deployed is false if the message was the first to bridge its token to the
+	// destination chain, so processing it deployed the bridged token.
+	Deployed bool
+	GasUsed  uint64
+}
+
+// FindAllAfterIDOpts selects the events indexed after the event with ID, oldest first.
+type FindAllAfterIDOpts struct {
+	ID int
+	// Events restricts the events returned to those with these event names.
+	Events []string
+	Limit  int
+}
+
+// DeleteAllAfterBlockIDOpts selects the events an indexer saved from blocks after
+// BlockID, which a reorg has replaced.
+type DeleteAllAfterBlockIDOpts struct {
+	BlockID     uint64
+	SrcChainID  uint64
+	DestChainID uint64
+	Events      []string
+}
+
 // EventRepository is used to interact with events in the store
 type EventRepository interface {
 	Close() error
 	Save(ctx context.Context, opts *SaveEventOpts) (*Event, error)
 	UpdateStatus(ctx context.Context, id int, status EventStatus) error
 	UpdateFeesAndProfitability(ctx context.Context, id int, opts *UpdateFeesAndProfitabilityOpts) error
+	UpdateProcessingGasUsed(ctx context.Context, id int, opts *UpdateProcessingGasUsedOpts) error
 	FindAllByAddress(
 		ctx context.Context,
 		req *http.Request,
@@ -146,6 +189,10 @@ type EventRepository interface {
 		event string,
 		msgHash string,
 	) (*Event, error)
+	FindAllByMsgHash(
+		ctx context.Context,
+		msgHash string,
+	) ([]*Event, error)
 	Delete(ctx context.Context, id int) error
 	ChainDataSyncedEventByBlockNumberOrGreater(
 		ctx context.Context,
@@ -158,11 +205,14 @@ type EventRepository interface {
 		srcChainId uint64,
 		syncedChainId uint64,
 	) (uint64, error)
-	DeleteAllAfterBlockID(blockID uint64, srcChainID uint64, destChainID uint64) error
+	DeleteAllAfterBlockID(ctx context.Context, opts DeleteAllAfterBlockIDOpts) error
 	FindLatestBlockID(
 		ctx context.Context,
 		event string,
 		srcChainID uint64,
 		destChainID uint64,
 	) (uint64, error)
+	FindProcessingCosts(ctx context.Context, opts FindProcessingCostsOpts) ([]ProcessingCost, error)
+	FindLatestID(ctx context.Context) (int, error)
+	FindAllAfterID(ctx context.Context, opts FindAllAfterIDOpts) ([]*Event, error)
 }
@@ -4,12 +4,12 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
-	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/rabbitmq"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/backend"
 	"github.com/urfave/cli/v2"
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
@@ -23,6 +23,7 @@ type Config struct {
 	SrcTaikoAddress         common.Address
 	DestBridgeAddress       common.Address
 	// db configs
+	DatabaseDialect         string
 	DatabaseUsername        string
 	DatabasePassword        string
 	DatabaseName            string
@@ -31,6 +32,8 @@ type Config struct {
 	DatabaseMaxOpenConns    uint64
 	DatabaseMaxConnLifetime uint64
 	// queue configs
+	QueueType     string
+	QueueDataDir  string
 	QueueUsername string
 	QueuePassword string
 	QueueHost     string
@@ -53,6 +56,7 @@ type Config struct {
 	MinFeeToIndex                    uint64
 	OpenQueueFunc                    func() (queue.Queue, error)
 	OpenDBFunc                       func() (db.DB, error)
+	DialRPCClientFunc                func(url string) (*rpc.Client, error)
 }
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
@@ -62,6 +66,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		SrcTaikoAddress:                  common.HexToAddress(c.String(flags.SrcTaikoAddress.Name)),
 		SrcSignalServiceAddress:          common.HexToAddress(c.String(flags.SrcSignalServiceAddress.Name)),
 		DestBridgeAddress:                common.HexToAddress(c.String(flags.DestBridgeAddress.Name)),
+		DatabaseDialect:                  c.String(flags.DatabaseDialect.Name),
 		DatabaseUsername:                 c.String(flags.DatabaseUsername.Name),
 		DatabasePassword:                 c.String(flags.DatabasePassword.Name),
 		DatabaseName:                     c.String(flags.DatabaseName.Name),
@@ -69,6 +74,8 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		DatabaseMaxIdleConns:             c.Uint64(flags.DatabaseMaxIdleConns.Name),
 		DatabaseMaxOpenConns:             c.Uint64(flags.DatabaseMaxOpenConns.Name),
 		DatabaseMaxConnLifetime:          c.Uint64(flags.DatabaseConnMaxLifetime.Name),
+		QueueType:                        c.String(flags.QueueType.Name),
+		QueueDataDir:                     c.String(flags.QueueDataDir.Name),
 		QueueUsername:                    c.String(flags.QueueUsername.Name),
 		QueuePassword:                    c.String(flags.QueuePassword.Name),
 		QueuePort:                        c.Uint64(flags.QueuePort.Name),
@@ -94,8 +101,10 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 			}
 			return nil
 		}(),
+		DialRPCClientFunc: rpc.Dial,
 		OpenDBFunc: func() (db.DB, error) {
 			return db.OpenDBConnection(db.DBConnectionOpts{
+				Dialect:         db.Dialect(c.String(flags.DatabaseDialect.Name)),
 				Name:            c.String(flags.DatabaseUsername.Name),
 				Password:        c.String(flags.DatabasePassword.Name),
 				Database:        c.String(flags.DatabaseName.Name),
@@ -103,8 +112,8 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 				MaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
 				MaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
 				MaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
-				OpenFunc: func(dsn string) (db.DB, error) {
-					gormDB, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+				OpenFunc: func(dialector gorm.Dialector) (db.DB, error) {
+					gormDB, err := gorm.Open(dialector, &gorm.Config{
 						Logger: logger.Default.LogMode(logger.Silent),
 					})
 					if err != nil {
@@ -121,9 +130,10 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 				Password: c.String(flags.QueuePassword.Name),
 				Host:     c.String(flags.QueueHost.Name),
 				Port:     c.String(flags.QueuePort.Name),
+				DataDir:  c.String(flags.QueueDataDir.Name),
 			}
 
-			q, err := rabbitmq.NewQueue(opts)
+			q, err := backend.Open(queue.Type(c.String(flags.QueueType.Name)), opts)
 			if err != nil {
 				return nil, err
 			}
@@ -47,10 +47,13 @@ func TestNewConfigFromCliContext(t *testing.T) {
 	app.Action = func(ctx *cli.Context) error {
 		c, err := NewConfigFromCliContext(ctx)
 		assert.Nil(t, err)
+		assert.Equal(t, "postgres", c.DatabaseDialect)
 		assert.Equal(t, "dbuser", c.DatabaseUsername)
 		assert.Equal(t, "dbpass", c.DatabasePassword)
 		assert.Equal(t, "dbname", c.DatabaseName)
 		assert.Equal(t, "dbhost", c.DatabaseHost)
+		assert.Equal(t, "inprocess", c.QueueType)
+		assert.Equal(t, "queuedatadir", c.QueueDataDir)
 		assert.Equal(t, "queuename", c.QueueUsername)
 		assert.Equal(t, "queuepassword", c.QueuePassword)
 		assert.Equal(t, "queuehost", c.QueueHost)
@@ -86,10 +89,13 @@ func TestNewConfigFromCliContext(t *testing.T) {
 
 	assert.Nil(t, app.Run([]string{
 		"TestNewConfigFromCliContext",
+		"--" + flags.DatabaseDialect.Name, "postgres",
 		"--" + flags.DatabaseUsername.Name, "dbuser",
 		"--" + flags.DatabasePassword.Name, "dbpass",
 		"--" + flags.DatabaseHost.Name, "dbhost",
 		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.QueueType.Name, "inprocess",
+		"--" + flags.QueueDataDir.Name, "queuedatadir",
 		"--" + flags.QueueUsername.Name, "queuename",
 		"--" + flags.QueuePassword.Name, "queuepassword",
 		"--" + flags.QueueHost.Name, "queuehost",
@@ -0,0 +1,154 @@
+package indexer
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/pkg/errors"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+var (
+	// maxReorgCheckpoints is how many of the latest checkpoints are kept, and so how
+	// many batches deep a reorg can be rolled back precisely.
+	maxReorgCheckpoints = 128
+)
+
+// detectReorg compares the block hashes of the latest checkpoints with the chain, the
+// newest first. If the newest no longer matches, the chain has reorged, and the indexer
+// rolls back to the newest checkpoint which still matches, the last block it indexed
+// before the fork.
+func (i *Indexer) detectReorg(ctx context.Context) error {
+	checkpoints, err := i.checkpointRepo.FindLatest(ctx, relayer.FindIndexerCheckpointsOpts{
+		ChainID:     i.srcChainId.Uint64(),
+		DestChainID: i.destChainId.Uint64(),
+		EventName:   i.eventName,
+		Limit:       maxReorgCheckpoints,
+	})
+	if err != nil {
+		return errors.Wrap(err, "i.checkpointRepo.FindLatest")
+	}
+
+	if len(checkpoints) == 0 {
+		return nil
+	}
+
+	for n, checkpoint := range checkpoints {
+		canonical, err := i.isCanonical(ctx, checkpoint)
+		if err != nil {
+			return err
+		}
+
+		if !canonical {
+			continue
+		}
+
+		if n == 0 {
+			return i.pruneCheckpoints(ctx, checkpoints)
+		}
+
+		slog.Warn("reorg detected",
+			"chainID", i.srcChainId.Uint64(),
+			"forkBlockNumber", checkpoint.BlockNumber,
+			"latestIndexedBlockNumber", i.latestIndexedBlockNumber,
+		)
+
+		return i.rollback(ctx, checkpoint.BlockNumber)
+	}
+
+	// the fork is older than every checkpoint, so roll back a batch further than the
+	// oldest one.
+	var forkBlockNumber uint64
+
+	oldest := checkpoints[len(checkpoints)-1].BlockNumber
+	if oldest > i.blockBatchSize {
+		forkBlockNumber = oldest - i.blockBatchSize
+	}
+
+	slog.Warn("reorg deeper than every checkpoint detected",
+		"chainID", i.srcChainId.Uint64(),
+		"oldestCheckpoint", oldest,
+		"forkBlockNumber", forkBlockNumber,
+	)
+
+	return i.rollback(ctx, forkBlockNumber)
+}
+
+// isCanonical returns whether the checkpointed block is still part of the chain. A
+// block which is no longer found has been reorged out by a shorter chain.
+func (i *Indexer) isCanonical(ctx context.Context, checkpoint *relayer.IndexerCheckpoint) (bool, error) {
+	header, err := i.srcEthClient.HeaderByNumber(ctx, new(big.Int).SetUint64(checkpoint.BlockNumber))
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return false, nil
+		}
+
+		return false, errors.Wrap(err, "i.srcEthClient.HeaderByNumber")
+	}
+
+	return header.Hash().Hex() == checkpoint.BlockHash, nil
+}
+
+// rollback deletes the events the indexer saved from blocks after forkBlockNumber,
+// and the checkpoints of those blocks, and rewinds the indexer so the events are
+// indexed and queued again from the canonical chain.
+func (i *Indexer) rollback(ctx context.Context, forkBlockNumber uint64) error {
+	if err := i.eventRepo.DeleteAllAfterBlockID(ctx, relayer.DeleteAllAfterBlockIDOpts{
+		BlockID:     forkBlockNumber,
+		SrcChainID:  i.srcChainId.Uint64(),
+		DestChainID: i.destChainId.Uint64(),
+		Events:      i.indexedEventNames(),
+	}); err != nil {
+		return errors.Wrap(err, "i.eventRepo.DeleteAllAfterBlockID")
+	}
+
+	if err := i.checkpointRepo.DeleteAfterBlockNumber(ctx, relayer.DeleteIndexerCheckpointsOpts{
+		ChainID:     i.srcChainId.Uint64(),
+		DestChainID: i.destChainId.Uint64(),
+		EventName:   i.eventName,
+		BlockNumber: forkBlockNumber,
+	}); err != nil {
+		return errors.Wrap(err, "i.checkpointRepo.DeleteAfterBlockNumber")
+	}
+
+	i.latestIndexedBlockNumber = forkBlockNumber
+
+	relayer.ReorgsDetected.Inc()
+
+	return nil
+}
+
+// pruneCheckpoints deletes the checkpoints older than the latest maxReorgCheckpoints.
+func (i *Indexer) pruneCheckpoints(ctx context.Context, checkpoints []*relayer.IndexerCheckpoint) error {
+	if len(checkpoints) < maxReorgCheckpoints {
+		return nil
+	}
+
+	if err := i.checkpointRepo.DeleteBeforeBlockNumber(ctx, relayer.DeleteIndexerCheckpointsOpts{
+		ChainID:     i.srcChainId.Uint64(),
+		DestChainID: i.destChainId.Uint64(),
+		EventName:   i.eventName,
+		BlockNumber: checkpoints[len(checkpoints)-1].BlockNumber,
+	}); err != nil {
+		return errors.Wrap(err, "i.checkpointRepo.DeleteBeforeBlockNumber")
+	}
+
+	return nil
+}
+
+// indexedEventNames returns the names of the events the indexer saves, which are
+// rolled back on a reorg.
+func (i *Indexer) indexedEventNames() []string {
+	if i.eventName == relayer.EventNameMessageProcessed {
+		return []string{relayer.EventNameMessageProcessed}
+	}
+
+	return []string{
+		relayer.EventNameMessageSent,
+		relayer.EventNameMessageStatusChanged,
+		relayer.EventNameChainDataSynced,
+	}
+}
@@ -0,0 +1,103 @@
+package indexer
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+// canonicalHash is the hash the mock eth client returns for the header at blockNumber.
+func canonicalHash(blockNumber uint64) string {
+	return (&types.Header{Number: new(big.Int).SetUint64(blockNumber)}).Hash().Hex()
+}
+
+func Test_detectReorg(t *testing.T) {
+	tests := []struct {
+		name                  string
+		checkpoints           map[uint64]string
+		wantLatestBlockNumber uint64
+		wantCheckpoints       int
+		wantEvents            int
+	}{
+		{
+			"noCheckpoints",
+			map[uint64]string{},
+			300,
+			0,
+			3,
+		},
+		{
+			"noReorg",
+			map[uint64]string{100: canonicalHash(100), 200: canonicalHash(200), 300: canonicalHash(300)},
+			300,
+			3,
+			3,
+		},
+		{
+			"reorgAfterCheckpoint",
+			map[uint64]string{100: canonicalHash(100), 200: canonicalHash(200), 300: "0x1"},
+			200,
+			2,
+			2,
+		},
+		{
+			"reorgAtEveryCheckpoint",
+			map[uint64]string{200: "0x1", 300: "0x1"},
+			100,
+			0,
+			1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _ := newTestService(Sync, FilterAndSubscribe)
+			svc.eventRepo = mock.NewEventRepository()
+			svc.latestIndexedBlockNumber = 300
+
+			for blockNumber, hash := range tt.checkpoints {
+				assert.Nil(t, svc.checkpointRepo.Save(context.Background(), relayer.SaveIndexerCheckpointOpts{
+					ChainID:     svc.srcChainId.Uint64(),
+					DestChainID: svc.destChainId.Uint64(),
+					EventName:   svc.eventName,
+					BlockNumber: blockNumber,
+					BlockHash:   hash,
+				}))
+			}
+
+			for _, emittedBlockID := range []uint64{100, 200, 300} {
+				_, err := svc.eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+					ChainID:        svc.srcChainId,
+					DestChainID:    svc.destChainId,
+					MsgHash:        "0x1",
+					Event:          relayer.EventNameMessageSent,
+					EmittedBlockID: emittedBlockID,
+				})
+				assert.Nil(t, err)
+			}
+
+			assert.Nil(t, svc.detectReorg(context.Background()))
+
+			assert.Equal(t, tt.wantLatestBlockNumber, svc.latestIndexedBlockNumber)
+
+			checkpoints, err := svc.checkpointRepo.FindLatest(context.Background(), relayer.FindIndexerCheckpointsOpts{
+				ChainID:     svc.srcChainId.Uint64(),
+				DestChainID: svc.destChainId.Uint64(),
+				EventName:   svc.eventName,
+				Limit:       maxReorgCheckpoints,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantCheckpoints, len(checkpoints))
+
+			events, err := svc.eventRepo.FindAllByMsgHash(context.Background(), "0x1")
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantEvents, len(events))
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/taikoxyz/taiko-mono/packages/relayer"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
@@ -74,7 +75,7 @@ func (i *Indexer) handleMessageProcessedEvent(
 	id, err := i.saveEventToDB(
 		ctx,
 		marshaled,
-		"0x",
+		common.Hash(event.MsgHash).Hex(),
 		chainID,
 		1,
 		message.SrcOwner.Hex(),
@@ -89,6 +90,7 @@ func (i *Indexer) handleMessageProcessedEvent(
 	msg := queue.QueueMessageProcessedBody{
 		ID:      id,
 		Message: message,
+		MsgHash: event.MsgHash,
 	}
 
 	marshalledMsg, err := json.Marshal(msg)
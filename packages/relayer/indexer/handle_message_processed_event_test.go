@@ -0,0 +1,39 @@
+package indexer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+func Test_handleMessageProcessedEvent(t *testing.T) {
+	svc, _ := newTestService(Sync, Filter)
+	svc.eventRepo = mock.NewEventRepository()
+	svc.eventName = relayer.EventNameMessageProcessed
+
+	processed := func(msgHash common.Hash) *bridge.BridgeMessageProcessed {
+		return &bridge.BridgeMessageProcessed{
+			MsgHash: msgHash,
+			Message: bridge.IBridgeMessage{DestChainId: mock.MockChainID.Uint64()},
+		}
+	}
+
+	// each processed message is saved under its own msgHash, and saved once even if
+	// its event is handled again.
+	for _, msgHash := range []common.Hash{common.HexToHash("0x1"), common.HexToHash("0x2"), common.HexToHash("0x1")} {
+		assert.Nil(t, svc.handleMessageProcessedEvent(context.Background(), mock.MockChainID, processed(msgHash), false))
+	}
+
+	for _, msgHash := range []common.Hash{common.HexToHash("0x1"), common.HexToHash("0x2")} {
+		events, err := svc.eventRepo.FindAllByMsgHash(context.Background(), msgHash.Hex())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(events))
+		assert.Equal(t, relayer.EventNameMessageProcessed, events[0].Event)
+	}
+}
@@ -2,7 +2,6 @@ package indexer
 
 import (
 	"context"
-	"fmt"
 	"log/slog"
 	"math/big"
 	"sync"
@@ -81,8 +80,9 @@ type ethClient interface {
 // as its source, and vice versa for the L2-L1 indexer. They will add messages to a queue
 // specifically for a processor of the same configuration.
 type Indexer struct {
-	eventRepo    relayer.EventRepository
-	srcEthClient ethClient
+	eventRepo      relayer.EventRepository
+	checkpointRepo relayer.IndexerCheckpointRepository
+	srcEthClient   ethClient
 
 	latestIndexedBlockNumber uint64
 
@@ -145,16 +145,25 @@ func InitFromConfig(ctx context.Context, i *Indexer, cfg *Config) (err error) {
 		return err
 	}
 
-	srcEthClient, err := ethclient.Dial(cfg.SrcRPCUrl)
+	checkpointRepository, err := repo.NewIndexerCheckpointRepository(db)
 	if err != nil {
 		return err
 	}
 
-	destEthClient, err := ethclient.Dial(cfg.DestRPCUrl)
+	srcRpcClient, err := cfg.DialRPCClientFunc(cfg.SrcRPCUrl)
 	if err != nil {
 		return err
 	}
 
+	srcEthClient := ethclient.NewClient(srcRpcClient)
+
+	destRpcClient, err := cfg.DialRPCClientFunc(cfg.DestRPCUrl)
+	if err != nil {
+		return err
+	}
+
+	destEthClient := ethclient.NewClient(destRpcClient)
+
 	q, err := cfg.OpenQueueFunc()
 	if err != nil {
 		return err
@@ -204,6 +213,7 @@ func InitFromConfig(ctx context.Context, i *Indexer, cfg *Config) (err error) {
 	}
 
 	i.eventRepo = eventRepository
+	i.checkpointRepo = checkpointRepository
 	i.srcEthClient = srcEthClient
 
 	i.bridge = srcBridge
@@ -370,7 +380,17 @@ func (i *Indexer) filter(ctx context.Context) error {
 
 	// iterate through from the starting block (i.latestIndexedBlockNumber) through the
 	// latest block (endBlockID) in batches of i.blockBatchSize until we are finished.
-	for j := i.latestIndexedBlockNumber + 1; j <= endBlockID; j += i.blockBatchSize {
+	for i.latestIndexedBlockNumber < endBlockID {
+		// check the blocks indexed so far are still canonical before indexing more,
+		// which rolls i.latestIndexedBlockNumber back to the fork point if not.
+		if i.watchMode != CrawlPastBlocks {
+			if err := i.detectReorg(ctx); err != nil {
+				return errors.Wrap(err, "i.detectReorg")
+			}
+		}
+
+		start := i.latestIndexedBlockNumber + 1
+
 		end := i.latestIndexedBlockNumber + i.blockBatchSize
 		// if the end of the batch is greater than the latest block number, set end
 		// to the latest block number
@@ -378,10 +398,17 @@ func (i *Indexer) filter(ctx context.Context) error {
 			end = endBlockID
 		}
 
-		slog.Info("block batch", "start", j, "end", end)
+		// the hash of the end block is fetched before filtering, so a reorg while
+		// filtering leaves a checkpoint which no longer matches the chain.
+		endHeader, err := i.srcEthClient.HeaderByNumber(ctx, new(big.Int).SetUint64(end))
+		if err != nil {
+			return errors.Wrap(err, "i.srcEthClient.HeaderByNumber")
+		}
+
+		slog.Info("block batch", "start", start, "end", end)
 
 		filterOpts := &bind.FilterOpts{
-			Start:   j,
+			Start:   start,
 			End:     &end,
 			Context: ctx,
 		}
@@ -418,6 +445,18 @@ func (i *Indexer) filter(ctx context.Context) error {
 		}
 
 		i.latestIndexedBlockNumber = end
+
+		if i.watchMode != CrawlPastBlocks {
+			if err := i.checkpointRepo.Save(ctx, relayer.SaveIndexerCheckpointOpts{
+				ChainID:     i.srcChainId.Uint64(),
+				DestChainID: i.destChainId.Uint64(),
+				EventName:   i.eventName,
+				BlockNumber: end,
+				BlockHash:   endHeader.Hash().Hex(),
+			}); err != nil {
+				return errors.Wrap(err, "i.checkpointRepo.Save")
+			}
+		}
 	}
 
 	return nil
@@ -436,19 +475,9 @@ func (i *Indexer) indexMessageSentEvents(ctx context.Context,
 	group, _ := errgroup.WithContext(ctx)
 	group.SetLimit(i.numGoroutines)
 
-	first := true
-
 	for events.Next() {
 		event := events.Event
 
-		if i.watchMode != CrawlPastBlocks && first {
-			first = false
-
-			if err := i.checkReorg(ctx, event.Raw.BlockNumber); err != nil {
-				return err
-			}
-		}
-
 		group.Go(func() error {
 			err := i.handleMessageSentEvent(ctx, i.srcChainId, event, true)
 			if err != nil {
@@ -471,21 +500,6 @@ func (i *Indexer) indexMessageSentEvents(ctx context.Context,
 	return nil
 }
 
-func (i *Indexer) checkReorg(ctx context.Context, emittedInBlockNumber uint64) error {
-	n, err := i.eventRepo.FindLatestBlockID(ctx, i.eventName, i.srcChainId.Uint64(), i.destChainId.Uint64())
-	if err != nil {
-		return err
-	}
-
-	if n >= emittedInBlockNumber {
-		slog.Info("reorg detected", "event emitted in", emittedInBlockNumber, "latest emitted block id from db", n)
-		// reorg detected, we have seen a higher block number than this already.
-		return i.eventRepo.DeleteAllAfterBlockID(emittedInBlockNumber, i.srcChainId.Uint64(), i.destChainId.Uint64())
-	}
-
-	return nil
-}
-
 // indexMessageProcessedEvents indexes `MessageProcessed` events on the bridge contract
 // and stores them to the database, and adds the message to the queue if it has not been
 // seen before.
@@ -500,19 +514,9 @@ func (i *Indexer) indexMessageProcessedEvents(ctx context.Context,
 	group, _ := errgroup.WithContext(ctx)
 	group.SetLimit(i.numGoroutines)
 
-	first := true
-
 	for events.Next() {
 		event := events.Event
 
-		if i.watchMode != CrawlPastBlocks && first {
-			first = false
-
-			if err := i.checkReorg(ctx, event.Raw.BlockNumber); err != nil {
-				return err
-			}
-		}
-
 		group.Go(func() error {
 			err := i.handleMessageProcessedEvent(ctx, i.srcChainId, event, true)
 			if err != nil {
@@ -624,7 +628,7 @@ func (i *Indexer) indexChainDataSyncedEvents(ctx context.Context,
 // queueName builds out the name of a queue, in the format the processor will also
 // use to listen to events.
 func (i *Indexer) queueName() string {
-	return fmt.Sprintf("%v-%v-%v-queue", i.srcChainId.String(), i.destChainId.String(), i.eventName)
+	return queue.Name(i.srcChainId, i.destChainId, i.eventName)
 }
 
 // withRetry retries the given function with prover backoff policy.
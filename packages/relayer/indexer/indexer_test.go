@@ -12,12 +12,13 @@ func newTestService(syncMode SyncMode, watchMode WatchMode) (*Indexer, relayer.B
 	b := &mock.Bridge{}
 
 	return &Indexer{
-		eventRepo:     &mock.EventRepository{},
-		bridge:        b,
-		destBridge:    b,
-		signalService: &mock.SignalService{},
-		srcEthClient:  &mock.EthClient{},
-		numGoroutines: 10,
+		eventRepo:      &mock.EventRepository{},
+		checkpointRepo: mock.NewIndexerCheckpointRepository(),
+		bridge:         b,
+		destBridge:     b,
+		signalService:  &mock.SignalService{},
+		srcEthClient:   &mock.EthClient{},
+		numGoroutines:  10,
 
 		latestIndexedBlockNumber: 0,
 		blockBatchSize:           100,
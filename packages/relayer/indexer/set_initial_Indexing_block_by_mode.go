@@ -26,7 +26,25 @@ func (i *Indexer) setInitialIndexingBlockByMode(
 
 	switch mode {
 	case Sync:
-		// get most recently processed block height from the DB
+		// resume from the last checkpoint, which is verified against the chain before
+		// indexing the next batch.
+		checkpoints, err := i.checkpointRepo.FindLatest(i.ctx, relayer.FindIndexerCheckpointsOpts{
+			ChainID:     chainID.Uint64(),
+			DestChainID: i.destChainId.Uint64(),
+			EventName:   i.eventName,
+			Limit:       1,
+		})
+		if err != nil {
+			return errors.Wrap(err, "svc.checkpointRepo.FindLatest")
+		}
+
+		if len(checkpoints) > 0 {
+			startingBlock = checkpoints[0].BlockNumber
+
+			break
+		}
+
+		// otherwise, get most recently processed block height from the DB
 		latest, err := i.eventRepo.FindLatestBlockID(
 			i.ctx,
 			i.eventName,
@@ -1,10 +1,12 @@
 package indexer
 
 import (
+	"context"
 	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
 )
 
@@ -60,3 +62,21 @@ func Test_setInitialIndexingBlockByMode(t *testing.T) {
 		})
 	}
 }
+
+func Test_setInitialIndexingBlockByMode_resumesFromCheckpoint(t *testing.T) {
+	svc, _ := newTestService(Sync, FilterAndSubscribe)
+
+	err := svc.checkpointRepo.Save(context.Background(), relayer.SaveIndexerCheckpointOpts{
+		ChainID:     mock.MockChainID.Uint64(),
+		DestChainID: mock.MockChainID.Uint64(),
+		EventName:   svc.eventName,
+		BlockNumber: 5,
+		BlockHash:   "0x1",
+	})
+	assert.Nil(t, err)
+
+	err = svc.setInitialIndexingBlockByMode(Sync, mock.MockChainID)
+	assert.Nil(t, err)
+
+	assert.Equal(t, uint64(5), svc.latestIndexedBlockNumber)
+}
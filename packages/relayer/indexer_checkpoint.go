@@ -0,0 +1,54 @@
+package relayer
+
+import (
+	"context"
+	"time"
+)
+
+// IndexerCheckpoint is the hash of the last block of a batch of blocks an indexer has
+// indexed events of. The checkpoints are compared against the chain to detect reorgs
+// which replaced blocks the indexer has already indexed.
+type IndexerCheckpoint struct {
+	ID          int       `json:"id"`
+	ChainID     uint64    `json:"chainID"`
+	DestChainID uint64    `json:"destChainID"`
+	EventName   string    `json:"eventName"`
+	BlockNumber uint64    `json:"blockNumber"`
+	BlockHash   string    `json:"blockHash"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// SaveIndexerCheckpointOpts
+type SaveIndexerCheckpointOpts struct {
+	ChainID     uint64
+	DestChainID uint64
+	EventName   string
+	BlockNumber uint64
+	BlockHash   string
+}
+
+// FindIndexerCheckpointsOpts selects the checkpoints of one indexer, the newest first.
+type FindIndexerCheckpointsOpts struct {
+	ChainID     uint64
+	DestChainID uint64
+	EventName   string
+	Limit       int
+}
+
+// DeleteIndexerCheckpointsOpts selects the checkpoints of one indexer on either side
+// of BlockNumber.
+type DeleteIndexerCheckpointsOpts struct {
+	ChainID     uint64
+	DestChainID uint64
+	EventName   string
+	BlockNumber uint64
+}
+
+// IndexerCheckpointRepository is used to interact with indexer checkpoints in the store
+type IndexerCheckpointRepository interface {
+	// Save saves a checkpoint, replacing the one at the same block number if any.
+	Save(ctx context.Context, opts SaveIndexerCheckpointOpts) error
+	FindLatest(ctx context.Context, opts FindIndexerCheckpointsOpts) ([]*IndexerCheckpoint, error)
+	DeleteAfterBlockNumber(ctx context.Context, opts DeleteIndexerCheckpointsOpts) error
+	DeleteBeforeBlockNumber(ctx context.Context, opts DeleteIndexerCheckpointsOpts) error
+}
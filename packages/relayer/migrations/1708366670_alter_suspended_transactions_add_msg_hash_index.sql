@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `suspended_transactions` ADD UNIQUE INDEX `suspended_transactions_msg_hash_index` (`msg_hash`);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX suspended_transactions_msg_hash_index on suspended_transactions;
+-- +goose StatementEnd
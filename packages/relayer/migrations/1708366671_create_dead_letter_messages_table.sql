@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS dead_letter_messages (
+    id int NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    message_id int NOT NULL,
+    src_chain_id int NOT NULL,
+    dest_chain_id int NOT NULL,
+    msg_hash VARCHAR(255) NOT NULL,
+    times_retried int NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL,
+    body JSON NOT NULL,
+    replayed boolean NOT NULL DEFAULT false,
+    replayed_at DATETIME NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX dead_letter_messages_msg_hash_index (msg_hash),
+    INDEX dead_letter_messages_src_chain_id_created_at_index (src_chain_id, created_at)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE dead_letter_messages;
+-- +goose StatementEnd
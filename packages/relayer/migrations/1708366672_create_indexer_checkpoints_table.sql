@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS indexer_checkpoints (
+    id int NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    chain_id int NOT NULL,
+    dest_chain_id int NOT NULL,
+    event_name VARCHAR(255) NOT NULL,
+    block_number BIGINT NOT NULL,
+    block_hash VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ,
+    UNIQUE INDEX indexer_checkpoints_chain_ids_event_name_block_number_index (chain_id, dest_chain_id, event_name, block_number)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE indexer_checkpoints;
+-- +goose StatementEnd
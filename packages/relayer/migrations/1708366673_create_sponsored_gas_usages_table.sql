@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS sponsored_gas_usages (
+    id int NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    policy VARCHAR(255) NOT NULL,
+    address VARCHAR(42) NOT NULL,
+    day VARCHAR(10) NOT NULL,
+    gas_used BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE INDEX sponsored_gas_usages_policy_address_day_index (policy, address, day),
+    INDEX sponsored_gas_usages_policy_day_index (policy, day)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE sponsored_gas_usages;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `events`
+ADD COLUMN `processing_gas_used` BIGINT UNSIGNED NULL,
+ADD COLUMN `processed_at` TIMESTAMP NULL,
+ADD INDEX `events_processing_cost_index` (`event`, `dest_chain_id`, `status`, `processed_at`),
+ADD INDEX `events_canonical_token_index` (`event`, `event_type`, `chain_id`, `dest_chain_id`, `canonical_token_address`, `id`);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `events`
+DROP INDEX `events_processing_cost_index`,
+DROP INDEX `events_canonical_token_index`,
+DROP COLUMN `processing_gas_used`,
+DROP COLUMN `processed_at`;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX message_owner_event_type_chain_id_index ON events (message_owner, event_type, chain_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX message_owner_event_type_chain_id_index;
+-- +goose StatementEnd
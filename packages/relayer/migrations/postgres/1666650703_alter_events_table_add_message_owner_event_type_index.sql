@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX message_owner_event_type_index ON events (message_owner, event_type);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX message_owner_event_type_index;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX message_owner_chain_id_index ON events (message_owner, chain_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX message_owner_chain_id_index;
+-- +goose StatementEnd
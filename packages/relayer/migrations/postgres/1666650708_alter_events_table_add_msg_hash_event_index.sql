@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX msg_hash_event_index ON events (msg_hash, event);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX msg_hash_event_index;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS suspended_transactions (
+    id SERIAL PRIMARY KEY,
+    message_id int NOT NULL,
+    src_chain_id int NOT NULL,
+    dest_chain_id int NOT NULL,
+    suspended boolean NOT NULL,
+    msg_hash VARCHAR(255) NOT NULL,
+    message_owner VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER suspended_transactions_set_updated_at BEFORE UPDATE ON suspended_transactions
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE suspended_transactions;
+-- +goose StatementEnd
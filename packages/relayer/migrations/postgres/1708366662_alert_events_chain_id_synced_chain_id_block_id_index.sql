@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX events_chain_id_synced_chain_id_block_id_index ON events (chain_id, synced_chain_id, block_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX events_chain_id_synced_chain_id_block_id_index;
+-- +goose StatementEnd
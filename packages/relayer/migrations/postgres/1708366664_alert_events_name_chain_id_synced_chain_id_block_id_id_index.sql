@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX events_name_cid_scid_bid ON events (name, chain_id, synced_chain_id, block_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX events_name_cid_scid_bid;
+-- +goose StatementEnd
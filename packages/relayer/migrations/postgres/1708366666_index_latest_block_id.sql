@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX idx_events_chain_ids ON events (chain_id, dest_chain_id, event);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_events_chain_ids;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE events ADD COLUMN dest_owner_json VARCHAR(255) GENERATED ALWAYS AS (data -> 'Message' ->> 'DestOwner') STORED;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE events DROP COLUMN dest_owner_json;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX message_owner_event_type_chain_id_event_data_index ON events (message_owner, dest_owner_json, event_type, chain_id, event);
+CREATE INDEX message_owner_event_type_chain_id_data_index ON events (message_owner, dest_owner_json, event_type, chain_id);
+CREATE INDEX message_owner_event_type_data_index ON events (message_owner, dest_owner_json, event_type);
+CREATE INDEX message_owner_data_index ON events (message_owner, dest_owner_json);
+CREATE INDEX message_owner_chain_id_data_index ON events (message_owner, dest_owner_json, chain_id);
+CREATE INDEX message_owner_event_data_index ON events (message_owner, dest_owner_json, event);
+CREATE INDEX msg_hash_event_data_index ON events (msg_hash, dest_owner_json, event);
+CREATE INDEX data_event_type_chain_id_index ON events (event_type, dest_owner_json, chain_id);
+CREATE INDEX data_event_type_index ON events (dest_owner_json, event_type);
+CREATE INDEX data_index ON events (dest_owner_json);
+CREATE INDEX data_chain_id_index ON events (dest_owner_json, chain_id);
+CREATE INDEX data_event_index ON events (dest_owner_json, event);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX
+  message_owner_event_type_chain_id_event_data_index,
+  message_owner_event_type_chain_id_data_index,
+  message_owner_event_type_data_index,
+  message_owner_data_index,
+  message_owner_chain_id_data_index,
+  message_owner_event_data_index,
+  msg_hash_event_data_index,
+  data_event_type_chain_id_index,
+  data_event_type_index,
+  data_index,
+  data_chain_id_index,
+  data_event_index;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- NUMERIC(20, 0) holds the full range of the BIGINT UNSIGNED columns in mysql.
+ALTER TABLE events
+ADD COLUMN fee NUMERIC(20, 0) NULL,
+ADD COLUMN dest_chain_base_fee NUMERIC(20, 0) NULL,
+ADD COLUMN gas_tip_cap NUMERIC(20, 0) NULL,
+ADD COLUMN gas_limit NUMERIC(20, 0) NULL,
+ADD COLUMN is_profitable BOOLEAN NULL,
+ADD COLUMN estimated_onchain_fee NUMERIC(20, 0) NULL,
+ADD COLUMN is_profitable_evaluated_at TIMESTAMP NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE events
+DROP COLUMN fee,
+DROP COLUMN dest_chain_base_fee,
+DROP COLUMN gas_tip_cap,
+DROP COLUMN gas_limit,
+DROP COLUMN is_profitable,
+DROP COLUMN estimated_onchain_fee,
+DROP COLUMN is_profitable_evaluated_at;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE UNIQUE INDEX suspended_transactions_msg_hash_index ON suspended_transactions (msg_hash);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX suspended_transactions_msg_hash_index;
+-- +goose StatementEnd
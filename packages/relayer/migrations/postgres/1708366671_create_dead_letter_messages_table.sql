@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS dead_letter_messages (
+    id SERIAL PRIMARY KEY,
+    message_id int NOT NULL,
+    src_chain_id int NOT NULL,
+    dest_chain_id int NOT NULL,
+    msg_hash VARCHAR(255) NOT NULL,
+    times_retried int NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL,
+    body JSONB NOT NULL,
+    replayed boolean NOT NULL DEFAULT false,
+    replayed_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX dead_letter_messages_msg_hash_index ON dead_letter_messages (msg_hash);
+CREATE INDEX dead_letter_messages_src_chain_id_created_at_index ON dead_letter_messages (src_chain_id, created_at);
+
+CREATE TRIGGER dead_letter_messages_set_updated_at BEFORE UPDATE ON dead_letter_messages
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE dead_letter_messages;
+-- +goose StatementEnd
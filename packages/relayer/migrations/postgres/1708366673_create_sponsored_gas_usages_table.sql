@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS sponsored_gas_usages (
+    id SERIAL PRIMARY KEY,
+    policy VARCHAR(255) NOT NULL,
+    address VARCHAR(42) NOT NULL,
+    day VARCHAR(10) NOT NULL,
+    gas_used BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX sponsored_gas_usages_policy_address_day_index ON sponsored_gas_usages (policy, address, day);
+CREATE INDEX sponsored_gas_usages_policy_day_index ON sponsored_gas_usages (policy, day);
+
+CREATE TRIGGER sponsored_gas_usages_set_updated_at BEFORE UPDATE ON sponsored_gas_usages
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE sponsored_gas_usages;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE events
+ADD COLUMN processing_gas_used NUMERIC(20, 0) NULL,
+ADD COLUMN processed_at TIMESTAMP NULL;
+
+CREATE INDEX events_processing_cost_index ON events (event, dest_chain_id, status, processed_at);
+CREATE INDEX events_canonical_token_index ON events (event, event_type, chain_id, dest_chain_id, canonical_token_address, id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX events_processing_cost_index;
+DROP INDEX events_canonical_token_index;
+
+ALTER TABLE events
+DROP COLUMN processing_gas_used,
+DROP COLUMN processed_at;
+-- +goose StatementEnd
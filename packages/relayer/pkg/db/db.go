@@ -2,21 +2,35 @@ package db
 
 import (
 	"fmt"
+	"net/url"
 	"time"
 
 	"database/sql"
 	"github.com/cyberhorsey/errors"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
 var (
-	ErrNoDB = errors.Validation.NewWithKeyAndDetail("ERR_NO_DB", "no db")
+	ErrNoDB           = errors.Validation.NewWithKeyAndDetail("ERR_NO_DB", "no db")
+	ErrInvalidDialect = errors.Validation.NewWithKeyAndDetail("ERR_INVALID_DIALECT", "invalid db dialect")
+)
+
+// Dialect is the SQL database engine the relayer stores its data in.
+type Dialect string
+
+var (
+	MySQL    Dialect = "mysql"
+	Postgres Dialect = "postgres"
+	Dialects         = []Dialect{MySQL, Postgres}
 )
 
 // DB is a local interface that lets us narrow down a database type for testing.
 type DB interface {
 	DB() (*sql.DB, error)
 	GormDB() *gorm.DB
+	Close() error
 }
 
 type Database struct {
@@ -31,13 +45,49 @@ func (db *Database) GormDB() *gorm.DB {
 	return db.gormdb
 }
 
+func (db *Database) Close() error {
+	sqlDB, err := db.gormdb.DB()
+	if err != nil {
+		return err
+	}
+
+	return sqlDB.Close()
+}
+
 func New(gormdb *gorm.DB) DB {
 	return &Database{
 		gormdb: gormdb,
 	}
 }
 
+// shared is a DB used by several components in one process. Closing it is a no-op,
+// so one component shutting down does not close the pool under the others; whoever
+// opened the underlying DB closes it once they have all stopped.
+type shared struct {
+	db DB
+}
+
+func (db *shared) DB() (*sql.DB, error) {
+	return db.db.DB()
+}
+
+func (db *shared) GormDB() *gorm.DB {
+	return db.db.GormDB()
+}
+
+func (db *shared) Close() error {
+	return nil
+}
+
+func NewShared(db DB) DB {
+	return &shared{
+		db: db,
+	}
+}
+
 type DBConnectionOpts struct {
+	// Dialect defaults to MySQL when empty.
+	Dialect         Dialect
 	Name            string
 	Password        string
 	Host            string
@@ -45,31 +95,66 @@ type DBConnectionOpts struct {
 	MaxIdleConns    uint64
 	MaxOpenConns    uint64
 	MaxConnLifetime uint64
-	OpenFunc        func(dsn string) (DB, error)
+	OpenFunc        func(dialector gorm.Dialector) (DB, error)
 }
 
-func OpenDBConnection(opts DBConnectionOpts) (DB, error) {
-	dsn := ""
+// Dialector returns the gorm dialector connecting to the database opts describe.
+func (opts DBConnectionOpts) Dialector() (gorm.Dialector, error) {
+	switch opts.Dialect {
+	case MySQL, "":
+		return mysql.Open(opts.mysqlDSN()), nil
+	case Postgres:
+		return postgres.Open(opts.postgresDSN()), nil
+	default:
+		return nil, ErrInvalidDialect
+	}
+}
+
+func (opts DBConnectionOpts) mysqlDSN() string {
 	if opts.Password == "" {
-		dsn = fmt.Sprintf(
+		return fmt.Sprintf(
 			"%v@tcp(%v)/%v?charset=utf8mb4&parseTime=True&loc=%v",
 			opts.Name,
 			opts.Host,
 			opts.Database,
 			"UTC",
 		)
-	} else {
-		dsn = fmt.Sprintf(
-			"%v:%v@tcp(%v)/%v?charset=utf8mb4&parseTime=True&loc=%v",
-			opts.Name,
-			opts.Password,
-			opts.Host,
-			opts.Database,
-			"UTC",
-		)
 	}
 
-	db, err := opts.OpenFunc(dsn)
+	return fmt.Sprintf(
+		"%v:%v@tcp(%v)/%v?charset=utf8mb4&parseTime=True&loc=%v",
+		opts.Name,
+		opts.Password,
+		opts.Host,
+		opts.Database,
+		"UTC",
+	)
+}
+
+func (opts DBConnectionOpts) postgresDSN() string {
+	user := url.User(opts.Name)
+	if opts.Password != "" {
+		user = url.UserPassword(opts.Name, opts.Password)
+	}
+
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     user,
+		Host:     opts.Host,
+		Path:     opts.Database,
+		RawQuery: "sslmode=prefer&TimeZone=UTC",
+	}
+
+	return dsn.String()
+}
+
+func OpenDBConnection(opts DBConnectionOpts) (DB, error) {
+	dialector, err := opts.Dialector()
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := opts.OpenFunc(dialector)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,40 @@
+package encoding
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Call3 is a call made by the aggregate3 function of a Multicall3 compatible contract.
+type Call3 struct {
+	Target       common.Address `abi:"target"`
+	AllowFailure bool           `abi:"allowFailure"`
+	CallData     []byte         `abi:"callData"`
+}
+
+// multicallABIJSON is the ABI of Multicall3's aggregate3, the only function of it
+// the relayer calls, which BridgeBatchProcessor implements too.
+const multicallABIJSON = `[{"inputs":[{"components":[` +
+	`{"internalType":"address","name":"target","type":"address"},` +
+	`{"internalType":"bool","name":"allowFailure","type":"bool"},` +
+	`{"internalType":"bytes","name":"callData","type":"bytes"}],` +
+	`"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],` +
+	`"name":"aggregate3","outputs":[{"components":[` +
+	`{"internalType":"bool","name":"success","type":"bool"},` +
+	`{"internalType":"bytes","name":"returnData","type":"bytes"}],` +
+	`"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],` +
+	`"stateMutability":"payable","type":"function"}]`
+
+var MulticallABI *abi.ABI
+
+func init() {
+	multicallABI, err := abi.JSON(strings.NewReader(multicallABIJSON))
+	if err != nil {
+		log.Crit("Get Multicall ABI error", "error", err)
+	}
+
+	MulticallABI = &multicallABI
+}
@@ -11,4 +11,28 @@ var (
 		"ERR_NO_REWARDER",
 		"Rewarder is required",
 	)
+	ErrMessageNotFound = errors.NotFound.NewWithKeyAndDetail(
+		"ERR_MESSAGE_NOT_FOUND",
+		"Message not found",
+	)
+	ErrSuspendedTransactionNotFound = errors.NotFound.NewWithKeyAndDetail(
+		"ERR_SUSPENDED_TRANSACTION_NOT_FOUND",
+		"Suspended transaction not found",
+	)
+	ErrNoSignalService = errors.Validation.NewWithKeyAndDetail(
+		"ERR_NO_SIGNAL_SERVICE",
+		"Messages sent from this chain can not be proven",
+	)
+	ErrMessageNotSynced = errors.Validation.NewWithKeyAndDetail(
+		"ERR_MESSAGE_NOT_SYNCED",
+		"Message has not been synced to its destination chain yet",
+	)
+	ErrMultiHopRoute = errors.Validation.NewWithKeyAndDetail(
+		"ERR_MULTI_HOP_ROUTE",
+		"Messages not sent between the chains the relayer is configured with can not be proven",
+	)
+	ErrNoStreamFilter = errors.Validation.NewWithKeyAndDetail(
+		"ERR_NO_STREAM_FILTER",
+		"address or msgHash is required",
+	)
 )
@@ -0,0 +1,195 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+var (
+	// streamedEvents are the events pushed to subscribers of the event stream.
+	streamedEvents = []string{
+		relayer.EventNameMessageSent,
+		relayer.EventNameMessageStatusChanged,
+		relayer.EventNameMessageProcessed,
+	}
+
+	// streamPollLimit is the most events read from the store per poll.
+	streamPollLimit = 1000
+
+	// streamPollOverlap is how many IDs before the last event streamed each poll reads
+	// again. Concurrent indexers can commit an event after events with higher IDs, so
+	// an event is still streamed if it commits before this many newer events do.
+	streamPollOverlap = 100
+
+	// subscriberBufferSize is how many events a subscriber can fall behind by before
+	// it is disconnected.
+	subscriberBufferSize = 64
+)
+
+// streamSubscriber receives the events of messages owned by owner, or with msgHash.
+type streamSubscriber struct {
+	owner   string
+	msgHash string
+	events  chan *relayer.Event
+}
+
+func (s *streamSubscriber) matches(e *relayer.Event) bool {
+	if s.msgHash != "" && !strings.EqualFold(s.msgHash, e.MsgHash) {
+		return false
+	}
+
+	if s.owner != "" && !strings.EqualFold(s.owner, e.MessageOwner) {
+		return false
+	}
+
+	return true
+}
+
+// eventStream polls the store for events the indexers save and fans them out to its
+// subscribers, so any number of API instances can stream them without coordinating
+// with the indexers.
+type eventStream struct {
+	eventRepo    relayer.EventRepository
+	pollInterval time.Duration
+
+	mu sync.Mutex
+	// startID is the latest event indexed when the stream started running, events up to
+	// it are never streamed.
+	startID int
+	lastID  int
+	// streamed are the IDs of the events streamed within the overlap window.
+	streamed    map[int]struct{}
+	subscribers map[*streamSubscriber]struct{}
+}
+
+func newEventStream(eventRepo relayer.EventRepository, pollInterval time.Duration) *eventStream {
+	return &eventStream{
+		eventRepo:    eventRepo,
+		pollInterval: pollInterval,
+		streamed:     make(map[int]struct{}),
+		subscribers:  make(map[*streamSubscriber]struct{}),
+	}
+}
+
+func (s *eventStream) subscribe(owner string, msgHash string) *streamSubscriber {
+	sub := &streamSubscriber{
+		owner:   owner,
+		msgHash: msgHash,
+		events:  make(chan *relayer.Event, subscriberBufferSize),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.subscribers[sub] = struct{}{}
+
+	return sub
+}
+
+// unsubscribe removes sub and closes its channel, unless it has been disconnected already.
+func (s *eventStream) unsubscribe(sub *streamSubscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subscribers[sub]; !ok {
+		return
+	}
+
+	delete(s.subscribers, sub)
+	close(sub.events)
+}
+
+// run polls for new events until ctx is done, starting from the latest event
+// indexed when it is called.
+func (s *eventStream) run(ctx context.Context) error {
+	lastID, err := s.eventRepo.FindLatestID(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.startID = lastID
+	s.lastID = lastID
+	s.mu.Unlock()
+
+	t := time.NewTicker(s.pollInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+			if err := s.poll(ctx); err != nil {
+				slog.Error("failed to poll events to stream", "error", err)
+			}
+		}
+	}
+}
+
+// poll reads the events indexed since the last poll, and within the overlap window
+// before it, and sends each one not streamed yet to the subscribers it matches. A
+// subscriber which has fallen too far behind is disconnected rather than holding up
+// the others.
+func (s *eventStream) poll(ctx context.Context) error {
+	s.mu.Lock()
+	afterID := max(s.lastID-streamPollOverlap, s.startID)
+	s.mu.Unlock()
+
+	events, err := s.eventRepo.FindAllAfterID(ctx, relayer.FindAllAfterIDOpts{
+		ID:     afterID,
+		Events: streamedEvents,
+		Limit:  streamPollLimit,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, e := range events {
+		if _, ok := s.streamed[e.ID]; ok {
+			continue
+		}
+
+		s.streamed[e.ID] = struct{}{}
+		s.lastID = max(s.lastID, e.ID)
+
+		for sub := range s.subscribers {
+			if !sub.matches(e) {
+				continue
+			}
+
+			select {
+			case sub.events <- e:
+			default:
+				slog.Warn("disconnecting slow event stream subscriber",
+					"owner", sub.owner,
+					"msgHash", sub.msgHash,
+				)
+
+				delete(s.subscribers, sub)
+				close(sub.events)
+			}
+		}
+	}
+
+	// forget the events which have left the overlap window, they will not be read again.
+	for id := range s.streamed {
+		if id <= s.lastID-streamPollOverlap {
+			delete(s.streamed, id)
+		}
+	}
+
+	return nil
+}
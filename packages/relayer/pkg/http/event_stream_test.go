@@ -0,0 +1,144 @@
+package http
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+func saveStreamedEvent(t *testing.T, repo relayer.EventRepository, event string, owner string, msgHash string) {
+	_, err := repo.Save(context.Background(), &relayer.SaveEventOpts{
+		Name:         event,
+		Data:         "{}",
+		ChainID:      big.NewInt(167001),
+		DestChainID:  big.NewInt(167002),
+		MsgHash:      msgHash,
+		MessageOwner: owner,
+		Event:        event,
+	})
+	assert.Nil(t, err)
+}
+
+func Test_eventStream_poll(t *testing.T) {
+	repo := mock.NewEventRepository()
+
+	owner := common.HexToAddress("0x1").Hex()
+	otherOwner := common.HexToAddress("0x2").Hex()
+	msgHash := common.HexToHash("0x1").Hex()
+	otherMsgHash := common.HexToHash("0x2").Hex()
+
+	// events indexed before the stream runs are not streamed.
+	saveStreamedEvent(t, repo, relayer.EventNameMessageSent, owner, otherMsgHash)
+
+	s := newEventStream(repo, time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Nil(t, s.run(ctx))
+
+	byOwner := s.subscribe(owner, "")
+	byMsgHash := s.subscribe("", msgHash)
+	byBoth := s.subscribe(owner, otherMsgHash)
+
+	saveStreamedEvent(t, repo, relayer.EventNameMessageSent, owner, msgHash)
+	saveStreamedEvent(t, repo, relayer.EventNameChainDataSynced, owner, msgHash)
+	saveStreamedEvent(t, repo, relayer.EventNameMessageStatusChanged, otherOwner, msgHash)
+
+	assert.Nil(t, s.poll(context.Background()))
+
+	assert.Equal(t, 1, len(byOwner.events))
+	assert.Equal(t, relayer.EventNameMessageSent, (<-byOwner.events).Event)
+
+	assert.Equal(t, 2, len(byMsgHash.events))
+	assert.Equal(t, relayer.EventNameMessageSent, (<-byMsgHash.events).Event)
+	assert.Equal(t, relayer.EventNameMessageStatusChanged, (<-byMsgHash.events).Event)
+
+	assert.Equal(t, 0, len(byBoth.events))
+
+	// events are only streamed once.
+	assert.Nil(t, s.poll(context.Background()))
+	assert.Equal(t, 0, len(byOwner.events))
+	assert.Equal(t, 0, len(byMsgHash.events))
+}
+
+// uncommittedEventRepo hides the event with ID uncommitted, as if the transaction saving
+// it had not committed yet.
+type uncommittedEventRepo struct {
+	*mock.EventRepository
+	uncommitted int
+}
+
+func (r *uncommittedEventRepo) FindAllAfterID(
+	ctx context.Context,
+	opts relayer.FindAllAfterIDOpts,
+) ([]*relayer.Event, error) {
+	events, err := r.EventRepository.FindAllAfterID(ctx, opts)
+
+	return slices.DeleteFunc(events, func(e *relayer.Event) bool { return e.ID == r.uncommitted }), err
+}
+
+func Test_eventStream_pollOutOfOrder(t *testing.T) {
+	repo := &uncommittedEventRepo{EventRepository: mock.NewEventRepository()}
+
+	msgHash := common.HexToHash("0x1").Hex()
+
+	s := newEventStream(repo, time.Second)
+
+	sub := s.subscribe("", msgHash)
+
+	for i := 0; i < 3; i++ {
+		saveStreamedEvent(t, repo, relayer.EventNameMessageStatusChanged, "", msgHash)
+	}
+
+	// the second event commits after the third one has been streamed.
+	repo.uncommitted = 2
+
+	assert.Nil(t, s.poll(context.Background()))
+	assert.Equal(t, 2, len(sub.events))
+	assert.Equal(t, 1, (<-sub.events).ID)
+	assert.Equal(t, 3, (<-sub.events).ID)
+
+	repo.uncommitted = 0
+
+	assert.Nil(t, s.poll(context.Background()))
+	assert.Equal(t, 1, len(sub.events))
+	assert.Equal(t, 2, (<-sub.events).ID)
+
+	// events read again within the overlap window are only streamed once.
+	assert.Nil(t, s.poll(context.Background()))
+	assert.Equal(t, 0, len(sub.events))
+}
+
+func Test_eventStream_slowSubscriber(t *testing.T) {
+	repo := mock.NewEventRepository()
+
+	msgHash := common.HexToHash("0x1").Hex()
+
+	s := newEventStream(repo, time.Second)
+
+	sub := s.subscribe("", msgHash)
+
+	for i := 0; i <= subscriberBufferSize; i++ {
+		saveStreamedEvent(t, repo, relayer.EventNameMessageStatusChanged, "", msgHash)
+	}
+
+	assert.Nil(t, s.poll(context.Background()))
+
+	for i := 0; i < subscriberBufferSize; i++ {
+		<-sub.events
+	}
+
+	// the subscriber was disconnected once its buffer was full.
+	_, ok := <-sub.events
+	assert.False(t, ok)
+
+	// unsubscribing a disconnected subscriber does not close its channel again.
+	s.unsubscribe(sub)
+}
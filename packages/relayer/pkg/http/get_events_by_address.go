@@ -1,6 +1,7 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
 	"html"
 	"math/big"
@@ -126,51 +127,66 @@ func (srv *Server) GetEventsByAddress(c echo.Context) error {
 			continue
 		}
 
-		r := &JSONData{}
-
-		if err := json.Unmarshal(msgProcessedEvent.Data, r); err != nil {
+		processedTxHash, claimedBy, err := srv.processedTx(c.Request().Context(), msgProcessedEvent)
+		if err != nil {
 			return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
 		}
 
-		if r.Raw.TransactionIndex == "" || r.Raw.TransactionHash == "" {
-			continue
-		}
+		v.ClaimedBy = claimedBy
 
-		var ethClient ethClient
+		v.ProcessedTxHash = processedTxHash
+	}
 
-		if new(big.Int).SetInt64(msgProcessedEvent.ChainID).Cmp(srv.srcChainID) == 0 {
-			ethClient = srv.srcEthClient
-		} else {
-			ethClient = srv.destEthClient
-		}
+	return c.JSON(http.StatusOK, page)
+}
 
-		tx, _, err := ethClient.TransactionByHash(
-			c.Request().Context(),
-			common.HexToHash(r.Raw.TransactionHash),
-		)
-		if err != nil {
-			return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
-		}
+// processedTx returns the hash of the transaction which emitted a
+// MessageStatusChanged event, and its sender, who claimed the message. Both are
+// empty if the event does not record its transaction.
+func (srv *Server) processedTx(
+	ctx context.Context,
+	msgStatusChangedEvent *relayer.Event,
+) (string, string, error) {
+	r := &JSONData{}
+
+	if err := json.Unmarshal(msgStatusChangedEvent.Data, r); err != nil {
+		return "", "", err
+	}
 
-		txIndex, err := strconv.ParseInt(r.Raw.TransactionIndex[2:], 16, 64)
-		if err != nil {
-			return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
-		}
+	if r.Raw.TransactionIndex == "" || r.Raw.TransactionHash == "" {
+		return "", "", nil
+	}
 
-		sender, err := ethClient.TransactionSender(
-			c.Request().Context(),
-			tx,
-			common.HexToHash(r.Raw.BlockHash),
-			uint(txIndex),
-		)
-		if err != nil {
-			return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
-		}
+	var ethClient ethClient
 
-		v.ClaimedBy = sender.Hex()
+	if new(big.Int).SetInt64(msgStatusChangedEvent.ChainID).Cmp(srv.srcChainID) == 0 {
+		ethClient = srv.srcEthClient
+	} else {
+		ethClient = srv.destEthClient
+	}
 
-		v.ProcessedTxHash = r.Raw.TransactionHash
+	tx, _, err := ethClient.TransactionByHash(
+		ctx,
+		common.HexToHash(r.Raw.TransactionHash),
+	)
+	if err != nil {
+		return "", "", err
 	}
 
-	return c.JSON(http.StatusOK, page)
+	txIndex, err := strconv.ParseInt(r.Raw.TransactionIndex[2:], 16, 64)
+	if err != nil {
+		return "", "", err
+	}
+
+	sender, err := ethClient.TransactionSender(
+		ctx,
+		tx,
+		common.HexToHash(r.Raw.BlockHash),
+		uint(txIndex),
+	)
+	if err != nil {
+		return "", "", err
+	}
+
+	return r.Raw.TransactionHash, sender.Hex(), nil
 }
@@ -0,0 +1,124 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/labstack/echo/v4"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+// messageRetries is how many times the relayer retried processing a message, counted
+// each time it dead-lettered the message after running out of retries, and those
+// dead-letterings, including ones since replayed.
+type messageRetries struct {
+	Count       uint64                       `json:"count"`
+	DeadLetters []*relayer.DeadLetterMessage `json:"deadLetters"`
+}
+
+type getMessageResponse struct {
+	MsgHash         string              `json:"msgHash"`
+	Status          relayer.EventStatus `json:"status"`
+	MessageSent     *relayer.Event      `json:"messageSent"`
+	StatusChanges   []*relayer.Event    `json:"statusChanges"`
+	ProcessedTxHash string              `json:"processedTxHash"`
+	ClaimedBy       string              `json:"claimedBy"`
+	Suspended       bool                `json:"suspended"`
+	Retries         messageRetries      `json:"retries"`
+}
+
+// GetMessage
+//
+//	 returns the lifecycle of a message by msgHash: the MessageSent event, every
+//	 status change, the transaction which processed it and who claimed it, and how
+//	 many times the relayer retried it.
+//
+//			@Summary		Get message
+//			@ID			   	get-message
+//		    @Param			msgHash	path		string		true	"msgHash to query"
+//			@Accept			json
+//			@Produce		json
+//			@Success		200	{object} getMessageResponse
+//			@Router			/message/{msgHash} [get]
+func (srv *Server) GetMessage(c echo.Context) error {
+	msgHash := common.HexToHash(c.Param("msgHash")).Hex()
+
+	events, err := srv.eventRepo.FindAllByMsgHash(c.Request().Context(), msgHash)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	resp := getMessageResponse{
+		MsgHash:       msgHash,
+		StatusChanges: make([]*relayer.Event, 0),
+		Retries: messageRetries{
+			DeadLetters: make([]*relayer.DeadLetterMessage, 0),
+		},
+	}
+
+	// the transaction which processed the message is the one which changed its status to
+	// DONE, or, if that event has not been indexed, the one which emitted MessageProcessed.
+	var done, processed *relayer.Event
+
+	for _, e := range events {
+		switch e.Event {
+		case relayer.EventNameMessageSent:
+			resp.MessageSent = e
+		case relayer.EventNameMessageStatusChanged:
+			resp.StatusChanges = append(resp.StatusChanges, e)
+
+			if e.Status == relayer.EventStatusDone && done == nil {
+				done = e
+			}
+		case relayer.EventNameMessageProcessed:
+			if processed == nil {
+				processed = e
+			}
+		}
+	}
+
+	if done != nil {
+		processed = done
+	}
+
+	if resp.MessageSent == nil {
+		return webutils.LogAndRenderErrors(c, http.StatusNotFound, ErrMessageNotFound)
+	}
+
+	resp.Status = resp.MessageSent.Status
+
+	if len(resp.StatusChanges) > 0 {
+		resp.Status = resp.StatusChanges[len(resp.StatusChanges)-1].Status
+	}
+
+	if processed != nil {
+		resp.ProcessedTxHash, resp.ClaimedBy, err = srv.processedTx(c.Request().Context(), processed)
+		if err != nil {
+			return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+		}
+	}
+
+	resp.Suspended, err = srv.suspendedTxRepo.IsSuspended(c.Request().Context(), msgHash)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	if srv.deadLetterRepo != nil {
+		deadLetters, err := srv.deadLetterRepo.Find(c.Request().Context(), relayer.FindDeadLetterMessagesOpts{
+			MsgHash:         &msgHash,
+			IncludeReplayed: true,
+		})
+		if err != nil {
+			return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+		}
+
+		for _, d := range deadLetters {
+			resp.Retries.Count += d.TimesRetried
+		}
+
+		resp.Retries.DeadLetters = append(resp.Retries.DeadLetters, deadLetters...)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,148 @@
+package http
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/labstack/echo/v4"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/proof"
+)
+
+type getMessageProofResponse struct {
+	MsgHash     string `json:"msgHash"`
+	SrcChainID  uint64 `json:"srcChainID"`
+	DestChainID uint64 `json:"destChainID"`
+	BlockID     uint64 `json:"blockID"`
+	Proof       string `json:"proof"`
+}
+
+// GetMessageProof
+//
+//	 returns the encoded signal proof of a message by msgHash, which can be passed to
+//	 processMessage on the destination bridge to claim the message without the relayer.
+//	 Only the messages sent between the relayer's source and destination chains, which
+//	 are proven in a single hop, can be proven.
+//
+//			@Summary		Get message proof
+//			@ID			   	get-message-proof
+//		    @Param			msgHash	path		string		true	"msgHash to prove"
+//			@Accept			json
+//			@Produce		json
+//			@Success		200	{object} getMessageProofResponse
+//			@Router			/message/{msgHash}/proof [get]
+func (srv *Server) GetMessageProof(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	msgHash := common.HexToHash(c.Param("msgHash")).Hex()
+
+	event, err := srv.eventRepo.FirstByEventAndMsgHash(ctx, relayer.EventNameMessageSent, msgHash)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	if event == nil {
+		return webutils.LogAndRenderErrors(c, http.StatusNotFound, ErrMessageNotFound)
+	}
+
+	msgSent := &bridge.BridgeMessageSent{}
+	if err := json.Unmarshal(event.Data, msgSent); err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	// the message is proven on the chain it was sent from.
+	var (
+		srcEthClient            ethClient
+		srcSignalService        relayer.SignalService
+		srcSignalServiceAddress common.Address
+		srcCaller               relayer.Caller
+		hopChainID              *big.Int
+	)
+
+	chainID := big.NewInt(event.ChainID)
+
+	switch {
+	case chainID.Cmp(srv.srcChainID) == 0:
+		srcEthClient = srv.srcEthClient
+		srcSignalService = srv.srcSignalService
+		srcSignalServiceAddress = srv.srcSignalServiceAddress
+		srcCaller = srv.srcCaller
+		hopChainID = srv.destChainID
+	case chainID.Cmp(srv.destChainID) == 0:
+		srcEthClient = srv.destEthClient
+		srcSignalService = srv.destSignalService
+		srcSignalServiceAddress = srv.destSignalServiceAddress
+		srcCaller = srv.destCaller
+		hopChainID = srv.srcChainID
+	}
+
+	// the proof only has a single hop, from the chain the message was sent from to the
+	// other chain the relayer is configured with, so it does not prove messages whose
+	// route goes through more chains.
+	if hopChainID == nil || hopChainID.Cmp(big.NewInt(event.DestChainID)) != 0 {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, ErrMultiHopRoute)
+	}
+
+	if srcSignalService == nil || srcCaller == nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, ErrNoSignalService)
+	}
+
+	srcChainID := uint64(event.ChainID)
+	destChainID := uint64(event.DestChainID)
+
+	// the proof is generated at the latest block of the source chain the destination
+	// chain has synced, which has to include the message.
+	blockID, err := srv.eventRepo.LatestChainDataSyncedEvent(ctx, destChainID, srcChainID)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	if blockID == 0 || blockID < msgSent.Raw.BlockNumber {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, ErrMessageNotSynced)
+	}
+
+	key, err := srcSignalService.GetSignalSlot(&bind.CallOpts{Context: ctx},
+		msgSent.Message.SrcChainId,
+		msgSent.Raw.Address,
+		msgSent.MsgHash,
+	)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	prover, err := proof.New(srcEthClient, encoding.CACHE_NOTHING)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	encodedSignalProof, err := prover.EncodedSignalProofWithHops(ctx, nil, []proof.HopParams{
+		{
+			ChainID:              new(big.Int).SetUint64(destChainID),
+			SrcChainID:           new(big.Int).SetUint64(srcChainID),
+			SignalServiceAddress: srcSignalServiceAddress,
+			SignalService:        srcSignalService,
+			Key:                  key,
+			Blocker:              srcEthClient,
+			Caller:               srcCaller,
+			BlockNumber:          blockID,
+		},
+	})
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	return c.JSON(http.StatusOK, getMessageProofResponse{
+		MsgHash:     msgHash,
+		SrcChainID:  srcChainID,
+		DestChainID: destChainID,
+		BlockID:     blockID,
+		Proof:       hexutil.Encode(encodedSignalProof),
+	})
+}
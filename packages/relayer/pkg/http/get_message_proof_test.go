@@ -0,0 +1,117 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cyberhorsey/webutils/testutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+func Test_GetMessageProof(t *testing.T) {
+	srv := newTestServer("")
+
+	srv.srcEthClient = &mock.EthClient{}
+	srv.srcChainID = mock.MockChainID
+	srv.srcSignalService = &mock.SignalService{}
+	srv.srcCaller = &mock.Caller{}
+	srv.destChainID = big.NewInt(167002)
+
+	// the mock repository has synced the source chain up to block 5.
+	saveMessageSent := func(msgHash common.Hash, chainID int64, destChainID int64, blockNumber uint64) {
+		data, err := json.Marshal(&bridge.BridgeMessageSent{
+			MsgHash: msgHash,
+			Message: bridge.IBridgeMessage{SrcChainId: uint64(chainID)},
+			Raw:     types.Log{BlockNumber: blockNumber, Topics: []common.Hash{}},
+		})
+		assert.Nil(t, err)
+
+		_, err = srv.eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+			Name:        relayer.EventNameMessageSent,
+			Data:        string(data),
+			ChainID:     big.NewInt(chainID),
+			DestChainID: big.NewInt(destChainID),
+			Status:      relayer.EventStatusNew,
+			MsgHash:     msgHash.Hex(),
+			Event:       relayer.EventNameMessageSent,
+		})
+		assert.Nil(t, err)
+	}
+
+	saveMessageSent(common.HexToHash("0x1"), mock.MockChainID.Int64(), 167002, 1)
+	saveMessageSent(common.HexToHash("0x2"), mock.MockChainID.Int64(), 167002, 10)
+	saveMessageSent(common.HexToHash("0x3"), 167002, mock.MockChainID.Int64(), 1)
+	saveMessageSent(common.HexToHash("0x5"), mock.MockChainID.Int64(), 167003, 1)
+	saveMessageSent(common.HexToHash("0x6"), 167003, 167002, 1)
+
+	tests := []struct {
+		name                  string
+		msgHash               string
+		wantStatus            int
+		wantBodyRegexpMatches []string
+	}{
+		{
+			"notFound",
+			common.HexToHash("0x4").Hex(),
+			http.StatusNotFound,
+			[]string{`ERR_MESSAGE_NOT_FOUND`},
+		},
+		{
+			"notSynced",
+			common.HexToHash("0x2").Hex(),
+			http.StatusUnprocessableEntity,
+			[]string{`ERR_MESSAGE_NOT_SYNCED`},
+		},
+		{
+			"noSignalService",
+			common.HexToHash("0x3").Hex(),
+			http.StatusUnprocessableEntity,
+			[]string{`ERR_NO_SIGNAL_SERVICE`},
+		},
+		{
+			"multiHopDestination",
+			common.HexToHash("0x5").Hex(),
+			http.StatusBadRequest,
+			[]string{`ERR_MULTI_HOP_ROUTE`},
+		},
+		{
+			"multiHopSource",
+			common.HexToHash("0x6").Hex(),
+			http.StatusBadRequest,
+			[]string{`ERR_MULTI_HOP_ROUTE`},
+		},
+		{
+			"success",
+			common.HexToHash("0x1").Hex(),
+			http.StatusOK,
+			[]string{`"srcChainID":167001`, `"destChainID":167002`, `"blockID":5`, `"proof":"0x[0-9a-f]+"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testutils.NewUnauthenticatedRequest(
+				echo.GET,
+				fmt.Sprintf("/message/%v/proof", tt.msgHash),
+				nil,
+			)
+
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, req)
+
+			testutils.AssertStatusAndBody(t, rec, tt.wantStatus, tt.wantBodyRegexpMatches)
+		})
+	}
+}
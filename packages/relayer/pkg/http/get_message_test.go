@@ -0,0 +1,131 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cyberhorsey/webutils/testutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+func Test_GetMessage(t *testing.T) {
+	srv := newTestServer("")
+	srv.srcChainID = big.NewInt(167001)
+	srv.srcEthClient = &mock.EthClient{}
+	srv.destEthClient = &mock.EthClient{}
+
+	msgHash := common.HexToHash("0x1").Hex()
+
+	_, err := srv.eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+		Name:        relayer.EventNameMessageSent,
+		Data:        `{"Message": {"Id": 1}}`,
+		ChainID:     big.NewInt(167001),
+		DestChainID: big.NewInt(167002),
+		Status:      relayer.EventStatusNew,
+		MsgHash:     msgHash,
+		Event:       relayer.EventNameMessageSent,
+	})
+	assert.Nil(t, err)
+
+	// the message is processed, but becomes retriable, until it is retried successfully.
+	retriableTxHash := common.HexToHash("0xaa").Hex()
+	doneTxHash := common.HexToHash("0xbb").Hex()
+
+	for _, e := range []struct {
+		status relayer.EventStatus
+		txHash string
+	}{
+		{relayer.EventStatusRetriable, retriableTxHash},
+		{relayer.EventStatusDone, doneTxHash},
+	} {
+		_, err = srv.eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+			Name:        relayer.EventNameMessageStatusChanged,
+			Data:        fmt.Sprintf(`{"Raw": {"transactionHash": "%v", "transactionIndex": "0x1"}}`, e.txHash),
+			ChainID:     big.NewInt(167002),
+			DestChainID: big.NewInt(167001),
+			Status:      e.status,
+			MsgHash:     msgHash,
+			Event:       relayer.EventNameMessageStatusChanged,
+		})
+		assert.Nil(t, err)
+	}
+
+	_, err = srv.deadLetterRepo.Save(context.Background(), relayer.SaveDeadLetterMessageOpts{
+		MessageID:    1,
+		SrcChainID:   167001,
+		DestChainID:  167002,
+		MsgHash:      msgHash,
+		TimesRetried: 5,
+		LastError:    "execution reverted",
+		Body:         []byte(`{}`),
+	})
+	assert.Nil(t, err)
+
+	_, err = srv.deadLetterRepo.Save(context.Background(), relayer.SaveDeadLetterMessageOpts{
+		MessageID:    1,
+		SrcChainID:   167001,
+		DestChainID:  167002,
+		MsgHash:      msgHash,
+		TimesRetried: 3,
+		LastError:    "execution reverted",
+		Body:         []byte(`{}`),
+	})
+	assert.Nil(t, err)
+
+	_, err = srv.suspendedTxRepo.Suspend(context.Background(), relayer.SuspendTransactionOpts{
+		MessageID: 1,
+		MsgHash:   msgHash,
+	})
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name                  string
+		msgHash               string
+		wantStatus            int
+		wantBodyRegexpMatches []string
+	}{
+		{
+			"notFound",
+			common.HexToHash("0x2").Hex(),
+			http.StatusNotFound,
+			[]string{`ERR_MESSAGE_NOT_FOUND`},
+		},
+		{
+			"success",
+			msgHash,
+			http.StatusOK,
+			[]string{
+				`"status":2`,
+				`"messageSent":{"id":\d+,"name":"MessageSent"`,
+				`"statusChanges":\[{"id":\d+,"name":"MessageStatusChanged"`,
+				fmt.Sprintf(`"processedTxHash":"%v"`, doneTxHash),
+				`"suspended":true`,
+				`"retries":{"count":8,"deadLetters":\[{.*"timesRetried":5,"lastError":"execution reverted"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testutils.NewUnauthenticatedRequest(
+				echo.GET,
+				fmt.Sprintf("/message/%v", tt.msgHash),
+				nil,
+			)
+
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, req)
+
+			testutils.AssertStatusAndBody(t, rec, tt.wantStatus, tt.wantBodyRegexpMatches)
+		})
+	}
+}
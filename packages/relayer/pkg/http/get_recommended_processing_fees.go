@@ -26,7 +26,8 @@ type fee struct {
 
 type FeeType uint64
 
-// gas limits
+// static gas limits, recommended when too few messages of a type were processed
+// recently to derive one from the gas they used.
 var (
 	Eth                FeeType = 900000
 	ERC20NotDeployed   FeeType = 1650000
@@ -119,19 +120,23 @@ func (srv *Server) GetRecommendedProcessingFees(c echo.Context) error {
 		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
 	}
 
+	srcGasLimits := srv.recommendedGasLimits(c.Request().Context(), srcChainID.Uint64())
+
+	destGasLimits := srv.recommendedGasLimits(c.Request().Context(), destChainID.Uint64())
+
 	for _, f := range feeTypes {
 		fees = append(fees, fee{
 			Type:        f.String(),
-			Amount:      srv.getCost(c.Request().Context(), uint64(f), destGasTipCap, destBaseFee, Layer1).String(),
+			Amount:      srv.getCost(c.Request().Context(), srcGasLimits[f], destGasTipCap, destBaseFee, Layer1).String(),
 			DestChainID: srcChainID.Uint64(),
-			GasLimit:    strconv.Itoa(int(f)),
+			GasLimit:    strconv.FormatUint(srcGasLimits[f], 10),
 		})
 
 		fees = append(fees, fee{
 			Type:        f.String(),
-			Amount:      srv.getCost(c.Request().Context(), uint64(f), srcGasTipCap, srcBaseFee, Layer2).String(),
+			Amount:      srv.getCost(c.Request().Context(), destGasLimits[f], srcGasTipCap, srcBaseFee, Layer2).String(),
 			DestChainID: destChainID.Uint64(),
-			GasLimit:    strconv.Itoa(int(f)),
+			GasLimit:    strconv.FormatUint(destGasLimits[f], 10),
 		})
 	}
 
@@ -0,0 +1,125 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+// gasLimitHeadroom is the percentage added on top of the gas used by sampled messages,
+// so that the messages using more gas than the percentile, or sent while the
+// destination chain state makes processing them costlier, are still processable.
+const gasLimitHeadroom = 20
+
+// gasLimitsCacheExpiration is how long the gas limits derived for a destination chain are
+// recommended for, before the processing costs are sampled again.
+const gasLimitsCacheExpiration = 1 * time.Minute
+
+// feeType returns the FeeType a processed message of eventType counts towards.
+func feeType(eventType relayer.EventType, deployed bool) FeeType {
+	switch eventType {
+	case relayer.EventTypeSendERC20:
+		if deployed {
+			return ERC20Deployed
+		}
+
+		return ERC20NotDeployed
+	case relayer.EventTypeSendERC721:
+		if deployed {
+			return ERC721Deployed
+		}
+
+		return ERC721NotDeployed
+	case relayer.EventTypeSendERC1155:
+		if deployed {
+			return ERC1155Deployed
+		}
+
+		return ERC1155NotDeployed
+	default:
+		return Eth
+	}
+}
+
+// recommendedGasLimits returns the gas limit to recommend for each FeeType of
+// messages to destChainID. It is the configured percentile of the gas used by the
+// messages of that type processed within the fee window, plus gasLimitHeadroom, or
+// the static gas limit of the FeeType when fewer than the minimum number of samples
+// were processed. The derived gas limits are cached for gasLimitsCacheExpiration.
+func (srv *Server) recommendedGasLimits(ctx context.Context, destChainID uint64) map[FeeType]uint64 {
+	cacheKey := strconv.FormatUint(destChainID, 10)
+
+	if cached, found := srv.gasLimitsCache.Get(cacheKey); found {
+		return cached.(map[FeeType]uint64)
+	}
+
+	gasLimits := make(map[FeeType]uint64, len(feeTypes))
+
+	for _, f := range feeTypes {
+		gasLimits[f] = uint64(f)
+	}
+
+	if srv.feeWindow == 0 {
+		return gasLimits
+	}
+
+	costs, err := srv.eventRepo.FindProcessingCosts(ctx, relayer.FindProcessingCostsOpts{
+		DestChainID: destChainID,
+		Since:       time.Now().UTC().Add(-srv.feeWindow),
+	})
+	if err != nil {
+		slog.Error("failed to find processing costs, using static gas limits", "error", err)
+
+		return gasLimits
+	}
+
+	samples := make(map[FeeType][]uint64)
+
+	for _, c := range costs {
+		f := feeType(c.EventType, c.Deployed)
+		samples[f] = append(samples[f], c.GasUsed)
+	}
+
+	for f, gasUsed := range samples {
+		if uint64(len(gasUsed)) < srv.feeMinSamples {
+			continue
+		}
+
+		gasLimits[f] = withHeadroom(percentile(gasUsed, srv.feePercentile))
+	}
+
+	srv.gasLimitsCache.Set(cacheKey, gasLimits, cache.DefaultExpiration)
+
+	return gasLimits
+}
+
+// withHeadroom returns gasUsed increased by gasLimitHeadroom, rounded up.
+func withHeadroom(gasUsed uint64) uint64 {
+	return (gasUsed*(100+gasLimitHeadroom) + 99) / 100
+}
+
+// percentile returns the nearest-rank p-th percentile of values, sorting values
+// in place.
+func percentile(values []uint64, p uint64) uint64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+
+	rank := (p*uint64(len(values)) + 99) / 100
+	if rank == 0 {
+		rank = 1
+	}
+
+	if rank > uint64(len(values)) {
+		rank = uint64(len(values))
+	}
+
+	return values[rank-1]
+}
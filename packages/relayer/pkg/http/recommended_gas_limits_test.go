@@ -0,0 +1,182 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+type processingCostsRepo struct {
+	*mock.EventRepository
+	costs []relayer.ProcessingCost
+	err   error
+	opts  relayer.FindProcessingCostsOpts
+	calls int
+}
+
+func (r *processingCostsRepo) FindProcessingCosts(
+	ctx context.Context,
+	opts relayer.FindProcessingCostsOpts,
+) ([]relayer.ProcessingCost, error) {
+	r.opts = opts
+	r.calls++
+
+	return r.costs, r.err
+}
+
+func Test_percentile(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []uint64
+		p      uint64
+		want   uint64
+	}{
+		{"empty", []uint64{}, 90, 0},
+		{"single", []uint64{5}, 90, 5},
+		{"p90", []uint64{10, 1, 9, 2, 8, 3, 7, 4, 6, 5}, 90, 9},
+		{"p50", []uint64{10, 1, 9, 2, 8, 3, 7, 4, 6, 5}, 50, 5},
+		{"p100", []uint64{3, 1, 2}, 100, 3},
+		{"p0", []uint64{3, 1, 2}, 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, percentile(tt.values, tt.p))
+		})
+	}
+}
+
+func Test_withHeadroom(t *testing.T) {
+	assert.Equal(t, uint64(0), withHeadroom(0))
+	assert.Equal(t, uint64(120000), withHeadroom(100000))
+	assert.Equal(t, uint64(2), withHeadroom(1))
+}
+
+func Test_recommendedGasLimits(t *testing.T) {
+	costs := []relayer.ProcessingCost{
+		{EventType: relayer.EventTypeSendETH, GasUsed: 100000},
+		{EventType: relayer.EventTypeSendETH, GasUsed: 120000},
+		{EventType: relayer.EventTypeSendETH, GasUsed: 110000},
+		{EventType: relayer.EventTypeSendERC20, Deployed: false, GasUsed: 1200000},
+		{EventType: relayer.EventTypeSendERC20, Deployed: false, GasUsed: 1300000},
+		{EventType: relayer.EventTypeSendERC20, Deployed: false, GasUsed: 1100000},
+		{EventType: relayer.EventTypeSendERC20, Deployed: true, GasUsed: 300000},
+	}
+
+	static := func() map[FeeType]uint64 {
+		gasLimits := make(map[FeeType]uint64)
+		for _, f := range feeTypes {
+			gasLimits[f] = uint64(f)
+		}
+
+		return gasLimits
+	}
+
+	tests := []struct {
+		name       string
+		window     time.Duration
+		minSamples uint64
+		err        error
+		want       func() map[FeeType]uint64
+	}{
+		{
+			"derivedWhereEnoughSamples",
+			time.Hour,
+			3,
+			nil,
+			func() map[FeeType]uint64 {
+				gasLimits := static()
+				gasLimits[Eth] = 144000
+				gasLimits[ERC20NotDeployed] = 1560000
+
+				return gasLimits
+			},
+		},
+		{
+			"staticBelowMinSamples",
+			time.Hour,
+			4,
+			nil,
+			static,
+		},
+		{
+			"disabled",
+			0,
+			3,
+			nil,
+			static,
+		},
+		{
+			"repoError",
+			time.Hour,
+			3,
+			errors.New("repo error"),
+			static,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &processingCostsRepo{
+				EventRepository: mock.NewEventRepository(),
+				costs:           costs,
+				err:             tt.err,
+			}
+
+			srv := &Server{
+				eventRepo:      repo,
+				feeWindow:      tt.window,
+				feePercentile:  90,
+				feeMinSamples:  tt.minSamples,
+				gasLimitsCache: cache.New(gasLimitsCacheExpiration, 2*gasLimitsCacheExpiration),
+			}
+
+			assert.Equal(t, tt.want(), srv.recommendedGasLimits(context.Background(), 167001))
+
+			if tt.window != 0 {
+				assert.Equal(t, uint64(167001), repo.opts.DestChainID)
+				assert.WithinDuration(t, time.Now().Add(-tt.window), repo.opts.Since, time.Minute)
+			}
+		})
+	}
+}
+
+func Test_recommendedGasLimits_cached(t *testing.T) {
+	repo := &processingCostsRepo{
+		EventRepository: mock.NewEventRepository(),
+		costs: []relayer.ProcessingCost{
+			{EventType: relayer.EventTypeSendETH, GasUsed: 100000},
+		},
+	}
+
+	srv := &Server{
+		eventRepo:      repo,
+		feeWindow:      time.Hour,
+		feePercentile:  90,
+		feeMinSamples:  1,
+		gasLimitsCache: cache.New(gasLimitsCacheExpiration, 2*gasLimitsCacheExpiration),
+	}
+
+	gasLimits := srv.recommendedGasLimits(context.Background(), 167001)
+	assert.Equal(t, uint64(120000), gasLimits[Eth])
+
+	// the processing costs are only sampled again once the cached gas limits expire.
+	assert.Equal(t, gasLimits, srv.recommendedGasLimits(context.Background(), 167001))
+	assert.Equal(t, 1, repo.calls)
+
+	// each destination chain has its own gas limits.
+	srv.recommendedGasLimits(context.Background(), 167002)
+	assert.Equal(t, 2, repo.calls)
+
+	// a failed query is not cached.
+	repo.err = errors.New("repo error")
+	srv.recommendedGasLimits(context.Background(), 167003)
+	srv.recommendedGasLimits(context.Background(), 167003)
+	assert.Equal(t, 4, repo.calls)
+}
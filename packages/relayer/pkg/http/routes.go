@@ -7,4 +7,18 @@ func (srv *Server) configureRoutes() {
 	srv.echo.GET("/events", srv.GetEventsByAddress)
 	srv.echo.GET("/blockInfo", srv.GetBlockInfo)
 	srv.echo.GET("/recommendedProcessingFees", srv.GetRecommendedProcessingFees)
+	srv.echo.GET("/suspendedTransactions", srv.GetSuspendedTransactions)
+	srv.echo.GET("/message/:msgHash", srv.GetMessage)
+	srv.echo.GET("/message/:msgHash/proof", srv.GetMessageProof)
+
+	if srv.eventStream != nil {
+		srv.echo.GET("/events/stream", srv.StreamEvents)
+	}
+
+	// suspending and unsuspending messages is only possible when an admin api key
+	// has been configured.
+	if srv.adminAPIKey != "" {
+		srv.echo.POST("/suspendedTransactions/:msgHash", srv.SuspendTransaction, srv.adminKeyAuth())
+		srv.echo.DELETE("/suspendedTransactions/:msgHash", srv.UnsuspendTransaction, srv.adminKeyAuth())
+	}
 }
@@ -2,14 +2,17 @@ package http
 
 import (
 	"context"
+	"crypto/subtle"
 	"log/slog"
 	"math/big"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/patrickmn/go-cache"
 	"github.com/taikoxyz/taiko-mono/packages/relayer"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/taikol2"
 
@@ -21,6 +24,7 @@ type ethClient interface {
 	ChainID(ctx context.Context) (*big.Int, error)
 	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
 	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+	BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error)
 	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
 	TransactionSender(ctx context.Context,
 		tx *types.Transaction,
@@ -43,24 +47,58 @@ type ethClient interface {
 // @host relayer.hekla.taiko.xyz
 // Server represents an relayer http server instance.
 type Server struct {
-	echo                    *echo.Echo
-	eventRepo               relayer.EventRepository
-	srcEthClient            ethClient
-	srcChainID              *big.Int
-	destEthClient           ethClient
-	destChainID             *big.Int
-	processingFeeMultiplier float64
-	taikoL2                 *taikol2.TaikoL2
+	echo                     *echo.Echo
+	eventRepo                relayer.EventRepository
+	suspendedTxRepo          relayer.SuspendedTransactionRepository
+	srcEthClient             ethClient
+	srcChainID               *big.Int
+	destEthClient            ethClient
+	destChainID              *big.Int
+	processingFeeMultiplier  float64
+	taikoL2                  *taikol2.TaikoL2
+	adminAPIKey              string
+	feeWindow                time.Duration
+	feePercentile            uint64
+	feeMinSamples            uint64
+	deadLetterRepo           relayer.DeadLetterMessageRepository
+	srcSignalService         relayer.SignalService
+	srcSignalServiceAddress  common.Address
+	srcCaller                relayer.Caller
+	destSignalService        relayer.SignalService
+	destSignalServiceAddress common.Address
+	destCaller               relayer.Caller
+	eventStream              *eventStream
+	gasLimitsCache           *cache.Cache
 }
 
 type NewServerOpts struct {
 	Echo                    *echo.Echo
 	EventRepo               relayer.EventRepository
+	SuspendedTxRepo         relayer.SuspendedTransactionRepository
 	CorsOrigins             []string
 	SrcEthClient            ethClient
 	DestEthClient           ethClient
 	ProcessingFeeMultiplier float64
 	TaikoL2                 *taikol2.TaikoL2
+	AdminAPIKey             string
+	// FeeWindow is how far back processed messages are sampled to recommend gas
+	// limits from. Zero always recommends the static gas limits.
+	FeeWindow     time.Duration
+	FeePercentile uint64
+	FeeMinSamples uint64
+	// DeadLetterRepo is optional, the retries of messages are only reported if set.
+	DeadLetterRepo relayer.DeadLetterMessageRepository
+	// the SignalService and RPC client of each chain are optional, messages sent
+	// from a chain can only be proven if they are set.
+	SrcSignalService         relayer.SignalService
+	SrcSignalServiceAddress  common.Address
+	SrcCaller                relayer.Caller
+	DestSignalService        relayer.SignalService
+	DestSignalServiceAddress common.Address
+	DestCaller               relayer.Caller
+	// StreamPollInterval is how often new events are read to push to subscribers of
+	// the event stream. Zero disables the event stream.
+	StreamPollInterval time.Duration
 }
 
 func (opts NewServerOpts) Validate() error {
@@ -72,6 +110,10 @@ func (opts NewServerOpts) Validate() error {
 		return relayer.ErrNoEventRepository
 	}
 
+	if opts.SuspendedTxRepo == nil {
+		return relayer.ErrNoSuspendedTransactionRepository
+	}
+
 	if opts.CorsOrigins == nil {
 		return relayer.ErrNoCORSOrigins
 	}
@@ -103,14 +145,31 @@ func NewServer(opts NewServerOpts) (*Server, error) {
 	}
 
 	srv := &Server{
-		echo:                    opts.Echo,
-		eventRepo:               opts.EventRepo,
-		srcEthClient:            opts.SrcEthClient,
-		destEthClient:           opts.DestEthClient,
-		processingFeeMultiplier: opts.ProcessingFeeMultiplier,
-		taikoL2:                 opts.TaikoL2,
-		srcChainID:              srcChainID,
-		destChainID:             destChainID,
+		echo:                     opts.Echo,
+		eventRepo:                opts.EventRepo,
+		suspendedTxRepo:          opts.SuspendedTxRepo,
+		srcEthClient:             opts.SrcEthClient,
+		destEthClient:            opts.DestEthClient,
+		processingFeeMultiplier:  opts.ProcessingFeeMultiplier,
+		taikoL2:                  opts.TaikoL2,
+		srcChainID:               srcChainID,
+		destChainID:              destChainID,
+		adminAPIKey:              opts.AdminAPIKey,
+		feeWindow:                opts.FeeWindow,
+		feePercentile:            opts.FeePercentile,
+		feeMinSamples:            opts.FeeMinSamples,
+		gasLimitsCache:           cache.New(gasLimitsCacheExpiration, 2*gasLimitsCacheExpiration),
+		deadLetterRepo:           opts.DeadLetterRepo,
+		srcSignalService:         opts.SrcSignalService,
+		srcSignalServiceAddress:  opts.SrcSignalServiceAddress,
+		srcCaller:                opts.SrcCaller,
+		destSignalService:        opts.DestSignalService,
+		destSignalServiceAddress: opts.DestSignalServiceAddress,
+		destCaller:               opts.DestCaller,
+	}
+
+	if opts.StreamPollInterval > 0 {
+		srv.eventStream = newEventStream(opts.EventRepo, opts.StreamPollInterval)
 	}
 
 	corsOrigins := opts.CorsOrigins
@@ -139,6 +198,14 @@ func (srv *Server) Shutdown(ctx context.Context) error {
 	return srv.echo.Shutdown(ctx)
 }
 
+// adminKeyAuth returns a middleware which only allows requests which provide the
+// configured admin api key as a bearer token.
+func (srv *Server) adminKeyAuth() echo.MiddlewareFunc {
+	return middleware.KeyAuth(func(key string, c echo.Context) (bool, error) {
+		return subtle.ConstantTimeCompare([]byte(key), []byte(srv.adminAPIKey)) == 1, nil
+	})
+}
+
 // ServeHTTP implements the `http.Handler` interface which serves HTTP requests
 func (srv *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	srv.echo.ServeHTTP(w, r)
@@ -5,23 +5,33 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/joho/godotenv"
 	echo "github.com/labstack/echo/v4"
+	"github.com/patrickmn/go-cache"
 	"github.com/stretchr/testify/assert"
 	"github.com/taikoxyz/taiko-mono/packages/relayer"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/repo"
 )
 
+var testAdminAPIKey = "testAdminAPIKey"
+
 func newTestServer(url string) *Server {
 	_ = godotenv.Load("../.test.env")
 
 	srv := &Server{
-		echo:      echo.New(),
-		eventRepo: mock.NewEventRepository(),
+		echo:            echo.New(),
+		eventRepo:       mock.NewEventRepository(),
+		suspendedTxRepo: mock.NewSuspendedTransactionRepository(),
+		deadLetterRepo:  mock.NewDeadLetterMessageRepository(),
+		adminAPIKey:     testAdminAPIKey,
+		gasLimitsCache:  cache.New(gasLimitsCacheExpiration, 2*gasLimitsCacheExpiration),
 	}
 
+	srv.eventStream = newEventStream(srv.eventRepo, time.Second)
+
 	srv.configureMiddleware([]string{"*"})
 	srv.configureRoutes()
 
@@ -37,31 +47,34 @@ func Test_NewServer(t *testing.T) {
 		{
 			"success",
 			NewServerOpts{
-				Echo:          echo.New(),
-				EventRepo:     &repo.EventRepository{},
-				CorsOrigins:   make([]string, 0),
-				SrcEthClient:  &mock.EthClient{},
-				DestEthClient: &mock.EthClient{},
+				Echo:            echo.New(),
+				EventRepo:       &repo.EventRepository{},
+				SuspendedTxRepo: &repo.SuspendedTransactionRepository{},
+				CorsOrigins:     make([]string, 0),
+				SrcEthClient:    &mock.EthClient{},
+				DestEthClient:   &mock.EthClient{},
 			},
 			nil,
 		},
 		{
 			"noSrcEthClient",
 			NewServerOpts{
-				Echo:          echo.New(),
-				EventRepo:     &repo.EventRepository{},
-				CorsOrigins:   make([]string, 0),
-				DestEthClient: &mock.EthClient{},
+				Echo:            echo.New(),
+				EventRepo:       &repo.EventRepository{},
+				SuspendedTxRepo: &repo.SuspendedTransactionRepository{},
+				CorsOrigins:     make([]string, 0),
+				DestEthClient:   &mock.EthClient{},
 			},
 			relayer.ErrNoEthClient,
 		},
 		{
 			"noDestEthClient",
 			NewServerOpts{
-				Echo:         echo.New(),
-				EventRepo:    &repo.EventRepository{},
-				CorsOrigins:  make([]string, 0),
-				SrcEthClient: &mock.EthClient{},
+				Echo:            echo.New(),
+				EventRepo:       &repo.EventRepository{},
+				SuspendedTxRepo: &repo.SuspendedTransactionRepository{},
+				CorsOrigins:     make([]string, 0),
+				SrcEthClient:    &mock.EthClient{},
 			},
 			relayer.ErrNoEthClient,
 		},
@@ -76,22 +89,35 @@ func Test_NewServer(t *testing.T) {
 			relayer.ErrNoEventRepository,
 		},
 		{
-			"noCorsOrigins",
+			"noSuspendedTxRepo",
 			NewServerOpts{
 				Echo:          echo.New(),
 				EventRepo:     &repo.EventRepository{},
+				CorsOrigins:   make([]string, 0),
 				SrcEthClient:  &mock.EthClient{},
 				DestEthClient: &mock.EthClient{},
 			},
+			relayer.ErrNoSuspendedTransactionRepository,
+		},
+		{
+			"noCorsOrigins",
+			NewServerOpts{
+				Echo:            echo.New(),
+				EventRepo:       &repo.EventRepository{},
+				SuspendedTxRepo: &repo.SuspendedTransactionRepository{},
+				SrcEthClient:    &mock.EthClient{},
+				DestEthClient:   &mock.EthClient{},
+			},
 			relayer.ErrNoCORSOrigins,
 		},
 		{
 			"noHttpFramework",
 			NewServerOpts{
-				EventRepo:     &repo.EventRepository{},
-				CorsOrigins:   make([]string, 0),
-				SrcEthClient:  &mock.EthClient{},
-				DestEthClient: &mock.EthClient{},
+				EventRepo:       &repo.EventRepository{},
+				SuspendedTxRepo: &repo.SuspendedTransactionRepository{},
+				CorsOrigins:     make([]string, 0),
+				SrcEthClient:    &mock.EthClient{},
+				DestEthClient:   &mock.EthClient{},
 			},
 			ErrNoHTTPFramework,
 		},
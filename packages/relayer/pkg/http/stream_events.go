@@ -0,0 +1,105 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/labstack/echo/v4"
+)
+
+// streamKeepAliveInterval is how often a comment is sent on an idle stream, so
+// proxies do not close the connection.
+var streamKeepAliveInterval = 15 * time.Second
+
+// StreamEvents
+//
+//	 streams MessageSent, MessageStatusChanged and MessageProcessed events as
+//	 Server-Sent Events, filtered by the owner of the message or its msgHash. Each
+//	 event is named after the event it carries, and its data is the event as returned
+//	 by /events.
+//
+//			@Summary		Stream events
+//			@ID			   	stream-events
+//		    @Param			address	query		string		false	"owner address to stream events of"
+//		    @Param			msgHash	query		string		false	"msgHash to stream events of"
+//			@Produce		text/event-stream
+//			@Success		200
+//			@Router			/events/stream [get]
+func (srv *Server) StreamEvents(c echo.Context) error {
+	address := html.EscapeString(c.QueryParam("address"))
+
+	msgHash := html.EscapeString(c.QueryParam("msgHash"))
+
+	if address == "" && msgHash == "" {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, ErrNoStreamFilter)
+	}
+
+	var owner string
+	if address != "" {
+		owner = common.HexToAddress(address).Hex()
+	}
+
+	if msgHash != "" {
+		msgHash = common.HexToHash(msgHash).Hex()
+	}
+
+	sub := srv.eventStream.subscribe(owner, msgHash)
+	defer srv.eventStream.unsubscribe(sub)
+
+	w := c.Response()
+	w.Header().Set(echo.HeaderContentType, "text/event-stream")
+	w.Header().Set(echo.HeaderCacheControl, "no-cache")
+	w.Header().Set(echo.HeaderConnection, "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	t := time.NewTicker(streamKeepAliveInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-c.Request().Context().Done():
+			return nil
+		case <-t.C:
+			if _, err := fmt.Fprint(w, ": keepalive\n\n"); err != nil {
+				return nil
+			}
+
+			w.Flush()
+		case e, ok := <-sub.events:
+			// the stream disconnected this subscriber for falling behind.
+			if !ok {
+				return nil
+			}
+
+			data, err := json.Marshal(e)
+			if err != nil {
+				slog.Error("failed to marshal streamed event", "error", err)
+				continue
+			}
+
+			if _, err := fmt.Fprintf(w, "id: %v\nevent: %v\ndata: %s\n\n", e.ID, e.Event, data); err != nil {
+				return nil
+			}
+
+			w.Flush()
+		}
+	}
+}
+
+// RunEventStream polls for events to stream until ctx is done. It returns
+// immediately if streaming is disabled.
+func (srv *Server) RunEventStream(ctx context.Context) error {
+	if srv.eventStream == nil {
+		return nil
+	}
+
+	return srv.eventStream.run(ctx)
+}
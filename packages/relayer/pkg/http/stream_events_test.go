@@ -0,0 +1,77 @@
+package http
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cyberhorsey/webutils/testutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+func Test_StreamEvents_noFilter(t *testing.T) {
+	srv := newTestServer("")
+
+	req := testutils.NewUnauthenticatedRequest(echo.GET, "/events/stream", nil)
+
+	rec := httptest.NewRecorder()
+
+	srv.ServeHTTP(rec, req)
+
+	testutils.AssertStatusAndBody(t, rec, http.StatusBadRequest, []string{`ERR_NO_STREAM_FILTER`})
+}
+
+func Test_StreamEvents(t *testing.T) {
+	srv := newTestServer("")
+
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	msgHash := common.HexToHash("0x1").Hex()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%v/events/stream?msgHash=%v", ts.URL, msgHash), nil)
+	assert.Nil(t, err)
+
+	resp, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get(echo.HeaderContentType))
+
+	// the subscriber is registered once the response headers are sent.
+	saveStreamedEvent(t, srv.eventRepo, relayer.EventNameMessageStatusChanged, "", msgHash)
+	assert.Nil(t, srv.eventStream.poll(ctx))
+
+	r := bufio.NewReader(resp.Body)
+
+	var lines []string
+
+	for {
+		line, err := r.ReadString('\n')
+		assert.Nil(t, err)
+
+		if line == "\n" {
+			break
+		}
+
+		lines = append(lines, strings.TrimSuffix(line, "\n"))
+	}
+
+	assert.Equal(t, 3, len(lines))
+	assert.True(t, strings.HasPrefix(lines[0], "id: "))
+	assert.Equal(t, "event: MessageStatusChanged", lines[1])
+	assert.Contains(t, lines[2], fmt.Sprintf(`"msgHash":"%v"`, msgHash))
+}
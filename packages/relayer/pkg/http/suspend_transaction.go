@@ -0,0 +1,59 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/labstack/echo/v4"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+// SuspendTransaction
+//
+//	 suspends a message by msgHash, so it will not be processed by the relayer
+//
+//			@Summary		Suspend transaction
+//			@ID			   	suspend-transaction
+//		    @Param			msgHash	path		string		true	"msgHash to suspend"
+//			@Accept			json
+//			@Produce		json
+//			@Success		200	{object} relayer.SuspendedTransaction
+//			@Router			/suspendedTransactions/{msgHash} [post]
+func (srv *Server) SuspendTransaction(c echo.Context) error {
+	msgHash := common.HexToHash(c.Param("msgHash")).Hex()
+
+	event, err := srv.eventRepo.FirstByEventAndMsgHash(
+		c.Request().Context(),
+		relayer.EventNameMessageSent,
+		msgHash,
+	)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	if event == nil {
+		return webutils.LogAndRenderErrors(c, http.StatusNotFound, ErrMessageNotFound)
+	}
+
+	data := &DataStruct{}
+	if err := json.Unmarshal(event.Data, data); err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	suspendedTx, err := srv.suspendedTxRepo.Suspend(c.Request().Context(), relayer.SuspendTransactionOpts{
+		MessageID:    data.Message.Id,
+		SrcChainID:   event.ChainID,
+		DestChainID:  event.DestChainID,
+		MsgHash:      msgHash,
+		MessageOwner: event.MessageOwner,
+	})
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	relayer.MessagesSuspended.Inc()
+
+	return c.JSON(http.StatusOK, suspendedTx)
+}
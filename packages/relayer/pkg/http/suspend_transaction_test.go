@@ -0,0 +1,146 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cyberhorsey/webutils/testutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+func Test_SuspendTransaction(t *testing.T) {
+	srv := newTestServer("")
+
+	msgHash := common.HexToHash("0x1").Hex()
+
+	_, err := srv.eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+		Name:        "name",
+		Data:        `{"Message": {"Id": 1}}`,
+		ChainID:     big.NewInt(167001),
+		DestChainID: big.NewInt(167002),
+		Status:      relayer.EventStatusNew,
+		MsgHash:     msgHash,
+		Event:       relayer.EventNameMessageSent,
+	})
+
+	assert.Equal(t, nil, err)
+
+	tests := []struct {
+		name                  string
+		msgHash               string
+		apiKey                string
+		wantStatus            int
+		wantBodyRegexpMatches []string
+	}{
+		{
+			"noApiKey",
+			msgHash,
+			"",
+			http.StatusBadRequest,
+			[]string{`missing key`},
+		},
+		{
+			"invalidApiKey",
+			msgHash,
+			"invalid",
+			http.StatusUnauthorized,
+			[]string{`Unauthorized`},
+		},
+		{
+			"notFound",
+			common.HexToHash("0x2").Hex(),
+			testAdminAPIKey,
+			http.StatusNotFound,
+			[]string{`ERR_MESSAGE_NOT_FOUND`},
+		},
+		{
+			"success",
+			msgHash,
+			testAdminAPIKey,
+			http.StatusOK,
+			[]string{`"messageID":1`, `"suspended":true`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testutils.NewUnauthenticatedRequest(
+				echo.POST,
+				fmt.Sprintf("/suspendedTransactions/%v", tt.msgHash),
+				nil,
+			)
+
+			if tt.apiKey != "" {
+				req.Header.Set(echo.HeaderAuthorization, "Bearer "+tt.apiKey)
+			}
+
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, req)
+
+			testutils.AssertStatusAndBody(t, rec, tt.wantStatus, tt.wantBodyRegexpMatches)
+		})
+	}
+
+	suspended, err := srv.suspendedTxRepo.IsSuspended(context.Background(), msgHash)
+	assert.Nil(t, err)
+	assert.True(t, suspended)
+}
+
+func Test_UnsuspendTransaction(t *testing.T) {
+	srv := newTestServer("")
+
+	msgHash := common.HexToHash("0x1").Hex()
+
+	_, err := srv.suspendedTxRepo.Suspend(context.Background(), relayer.SuspendTransactionOpts{
+		MessageID: 1,
+		MsgHash:   msgHash,
+	})
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name       string
+		msgHash    string
+		wantStatus int
+	}{
+		{
+			"notFound",
+			common.HexToHash("0x2").Hex(),
+			http.StatusNotFound,
+		},
+		{
+			"success",
+			msgHash,
+			http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testutils.NewUnauthenticatedRequest(
+				echo.DELETE,
+				fmt.Sprintf("/suspendedTransactions/%v", tt.msgHash),
+				nil,
+			)
+
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+testAdminAPIKey)
+
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+
+	suspended, err := srv.suspendedTxRepo.IsSuspended(context.Background(), msgHash)
+	assert.Nil(t, err)
+	assert.False(t, suspended)
+}
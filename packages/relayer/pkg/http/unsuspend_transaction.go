@@ -0,0 +1,35 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// UnsuspendTransaction
+//
+//	 unsuspends a previously suspended message by msgHash
+//
+//			@Summary		Unsuspend transaction
+//			@ID			   	unsuspend-transaction
+//		    @Param			msgHash	path		string		true	"msgHash to unsuspend"
+//			@Accept			json
+//			@Produce		json
+//			@Success		204
+//			@Router			/suspendedTransactions/{msgHash} [delete]
+func (srv *Server) UnsuspendTransaction(c echo.Context) error {
+	msgHash := common.HexToHash(c.Param("msgHash")).Hex()
+
+	if err := srv.suspendedTxRepo.Unsuspend(c.Request().Context(), msgHash); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return webutils.LogAndRenderErrors(c, http.StatusNotFound, ErrSuspendedTransactionNotFound)
+		}
+
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
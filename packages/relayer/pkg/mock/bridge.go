@@ -98,3 +98,7 @@ func (b *Bridge) IsMessageReceived(opts *bind.CallOpts, _message bridge.IBridgeM
 func (b *Bridge) Paused(opts *bind.CallOpts) (bool, error) {
 	return false, nil
 }
+
+func (b *Bridge) HashMessage(opts *bind.CallOpts, _message bridge.IBridgeMessage) ([32]byte, error) {
+	return SuccessMsgHash, nil
+}
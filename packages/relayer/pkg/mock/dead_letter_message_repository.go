@@ -0,0 +1,88 @@
+package mock
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"gorm.io/datatypes"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+type DeadLetterMessageRepository struct {
+	msgs []*relayer.DeadLetterMessage
+}
+
+func NewDeadLetterMessageRepository() *DeadLetterMessageRepository {
+	return &DeadLetterMessageRepository{
+		msgs: make([]*relayer.DeadLetterMessage, 0),
+	}
+}
+
+func (r *DeadLetterMessageRepository) Save(
+	ctx context.Context,
+	opts relayer.SaveDeadLetterMessageOpts,
+) (*relayer.DeadLetterMessage, error) {
+	m := &relayer.DeadLetterMessage{
+		ID:           rand.Int(), // nolint: gosec
+		MessageID:    opts.MessageID,
+		SrcChainID:   opts.SrcChainID,
+		DestChainID:  opts.DestChainID,
+		MsgHash:      opts.MsgHash,
+		TimesRetried: opts.TimesRetried,
+		LastError:    opts.LastError,
+		Body:         datatypes.JSON(opts.Body),
+		CreatedAt:    time.Now(),
+	}
+
+	r.msgs = append(r.msgs, m)
+
+	return m, nil
+}
+
+func (r *DeadLetterMessageRepository) Find(
+	ctx context.Context,
+	opts relayer.FindDeadLetterMessagesOpts,
+) ([]*relayer.DeadLetterMessage, error) {
+	msgs := make([]*relayer.DeadLetterMessage, 0)
+
+	for _, m := range r.msgs {
+		if m.Replayed && !opts.IncludeReplayed {
+			continue
+		}
+
+		if opts.MsgHash != nil && m.MsgHash != *opts.MsgHash {
+			continue
+		}
+
+		if opts.SrcChainID != nil && m.SrcChainID != *opts.SrcChainID {
+			continue
+		}
+
+		if opts.Since != nil && m.CreatedAt.Before(*opts.Since) {
+			continue
+		}
+
+		if opts.Until != nil && m.CreatedAt.After(*opts.Until) {
+			continue
+		}
+
+		msgs = append(msgs, m)
+	}
+
+	return msgs, nil
+}
+
+func (r *DeadLetterMessageRepository) MarkReplayed(ctx context.Context, id int) error {
+	for _, m := range r.msgs {
+		if m.ID == id {
+			now := time.Now()
+
+			m.Replayed = true
+			m.ReplayedAt = &now
+		}
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"math/rand"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/morkid/paginate"
@@ -15,6 +16,7 @@ import (
 
 type EventRepository struct {
 	events []*relayer.Event
+	lastID int
 }
 
 func NewEventRepository() *EventRepository {
@@ -28,19 +30,26 @@ func (r *EventRepository) Close() error {
 }
 
 func (r *EventRepository) Save(ctx context.Context, opts *relayer.SaveEventOpts) (*relayer.Event, error) {
-	r.events = append(r.events, &relayer.Event{
-		ID:           rand.Int(), // nolint: gosec
-		Data:         datatypes.JSON(opts.Data),
-		Status:       opts.Status,
-		ChainID:      opts.ChainID.Int64(),
-		DestChainID:  opts.DestChainID.Int64(),
-		Name:         opts.Name,
-		MessageOwner: opts.MessageOwner,
-		MsgHash:      opts.MsgHash,
-		EventType:    opts.EventType,
-	})
+	r.lastID++
+
+	event := &relayer.Event{
+		ID:                    r.lastID,
+		Data:                  datatypes.JSON(opts.Data),
+		Status:                opts.Status,
+		ChainID:               opts.ChainID.Int64(),
+		DestChainID:           opts.DestChainID.Int64(),
+		Name:                  opts.Name,
+		MessageOwner:          opts.MessageOwner,
+		MsgHash:               opts.MsgHash,
+		EventType:             opts.EventType,
+		Event:                 opts.Event,
+		CanonicalTokenAddress: opts.CanonicalTokenAddress,
+		EmittedBlockID:        opts.EmittedBlockID,
+	}
 
-	return nil, nil
+	r.events = append(r.events, event)
+
+	return event, nil
 }
 
 func (r *EventRepository) UpdateStatus(ctx context.Context, id int, status relayer.EventStatus) error {
@@ -106,6 +115,23 @@ func (r *EventRepository) UpdateFeesAndProfitability(
 	return nil
 }
 
+func (r *EventRepository) UpdateProcessingGasUsed(
+	ctx context.Context,
+	id int,
+	opts *relayer.UpdateProcessingGasUsedOpts,
+) error {
+	for _, e := range r.events {
+		if e.ID == id {
+			e.ProcessingGasUsed = &opts.GasUsed
+			e.ProcessedAt = &opts.ProcessedAt
+
+			break
+		}
+	}
+
+	return nil
+}
+
 func (r *EventRepository) FindAllByAddress(
 	ctx context.Context,
 	req *http.Request,
@@ -170,6 +196,21 @@ func (r *EventRepository) FirstByEventAndMsgHash(
 	return nil, nil
 }
 
+func (r *EventRepository) FindAllByMsgHash(
+	ctx context.Context,
+	msgHash string,
+) ([]*relayer.Event, error) {
+	events := make([]*relayer.Event, 0)
+
+	for _, e := range r.events {
+		if e.MsgHash == msgHash {
+			events = append(events, e)
+		}
+	}
+
+	return events, nil
+}
+
 func (r *EventRepository) Delete(
 	ctx context.Context,
 	id int,
@@ -204,7 +245,14 @@ func (r *EventRepository) LatestChainDataSyncedEvent(
 }
 
 // DeleteAllAfterBlockID is used when a reorg is detected
-func (r *EventRepository) DeleteAllAfterBlockID(blockID uint64, srcChainID uint64, destChainID uint64) error {
+func (r *EventRepository) DeleteAllAfterBlockID(ctx context.Context, opts relayer.DeleteAllAfterBlockIDOpts) error {
+	r.events = slices.DeleteFunc(r.events, func(e *relayer.Event) bool {
+		return e.EmittedBlockID > opts.BlockID &&
+			e.ChainID == int64(opts.SrcChainID) &&
+			e.DestChainID == int64(opts.DestChainID) &&
+			slices.Contains(opts.Events, e.Event)
+	})
+
 	return nil
 }
 
@@ -221,3 +269,67 @@ func (r *EventRepository) FindLatestBlockID(
 
 	return 0, errors.New("invalid")
 }
+
+func (r *EventRepository) FindProcessingCosts(
+	ctx context.Context,
+	opts relayer.FindProcessingCostsOpts,
+) ([]relayer.ProcessingCost, error) {
+	costs := make([]relayer.ProcessingCost, 0)
+
+	bridged := make(map[string]bool)
+
+	for _, e := range r.events {
+		if e.Event != relayer.EventNameMessageSent || uint64(e.DestChainID) != opts.DestChainID {
+			continue
+		}
+
+		deployed := bridged[e.CanonicalTokenAddress]
+		bridged[e.CanonicalTokenAddress] = true
+
+		if e.Status != relayer.EventStatusDone || e.ProcessedAt == nil || e.ProcessedAt.Before(opts.Since) ||
+			e.ProcessingGasUsed == nil || *e.ProcessingGasUsed == 0 {
+			continue
+		}
+
+		costs = append(costs, relayer.ProcessingCost{
+			EventType: e.EventType,
+			Deployed:  deployed,
+			GasUsed:   *e.ProcessingGasUsed,
+		})
+	}
+
+	return costs, nil
+}
+
+func (r *EventRepository) FindLatestID(ctx context.Context) (int, error) {
+	if len(r.events) == 0 {
+		return 0, nil
+	}
+
+	return r.events[len(r.events)-1].ID, nil
+}
+
+func (r *EventRepository) FindAllAfterID(
+	ctx context.Context,
+	opts relayer.FindAllAfterIDOpts,
+) ([]*relayer.Event, error) {
+	events := make([]*relayer.Event, 0)
+
+	for _, e := range r.events {
+		if e.ID <= opts.ID {
+			continue
+		}
+
+		if len(opts.Events) > 0 && !slices.Contains(opts.Events, e.Event) {
+			continue
+		}
+
+		events = append(events, e)
+
+		if opts.Limit > 0 && len(events) == opts.Limit {
+			break
+		}
+	}
+
+	return events, nil
+}
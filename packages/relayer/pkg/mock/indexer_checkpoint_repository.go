@@ -0,0 +1,102 @@
+package mock
+
+import (
+	"context"
+	"sort"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+type IndexerCheckpointRepository struct {
+	checkpoints []*relayer.IndexerCheckpoint
+}
+
+func NewIndexerCheckpointRepository() *IndexerCheckpointRepository {
+	return &IndexerCheckpointRepository{
+		checkpoints: make([]*relayer.IndexerCheckpoint, 0),
+	}
+}
+
+func (r *IndexerCheckpointRepository) Save(
+	ctx context.Context,
+	opts relayer.SaveIndexerCheckpointOpts,
+) error {
+	for _, c := range r.checkpoints {
+		if c.ChainID == opts.ChainID && c.DestChainID == opts.DestChainID &&
+			c.EventName == opts.EventName && c.BlockNumber == opts.BlockNumber {
+			c.BlockHash = opts.BlockHash
+
+			return nil
+		}
+	}
+
+	r.checkpoints = append(r.checkpoints, &relayer.IndexerCheckpoint{
+		ID:          len(r.checkpoints) + 1,
+		ChainID:     opts.ChainID,
+		DestChainID: opts.DestChainID,
+		EventName:   opts.EventName,
+		BlockNumber: opts.BlockNumber,
+		BlockHash:   opts.BlockHash,
+	})
+
+	return nil
+}
+
+func (r *IndexerCheckpointRepository) FindLatest(
+	ctx context.Context,
+	opts relayer.FindIndexerCheckpointsOpts,
+) ([]*relayer.IndexerCheckpoint, error) {
+	checkpoints := make([]*relayer.IndexerCheckpoint, 0)
+
+	for _, c := range r.checkpoints {
+		if c.ChainID == opts.ChainID && c.DestChainID == opts.DestChainID && c.EventName == opts.EventName {
+			checkpoints = append(checkpoints, c)
+		}
+	}
+
+	sort.Slice(checkpoints, func(i, j int) bool {
+		return checkpoints[i].BlockNumber > checkpoints[j].BlockNumber
+	})
+
+	if len(checkpoints) > opts.Limit {
+		checkpoints = checkpoints[:opts.Limit]
+	}
+
+	return checkpoints, nil
+}
+
+func (r *IndexerCheckpointRepository) DeleteAfterBlockNumber(
+	ctx context.Context,
+	opts relayer.DeleteIndexerCheckpointsOpts,
+) error {
+	r.delete(opts, func(c *relayer.IndexerCheckpoint) bool { return c.BlockNumber > opts.BlockNumber })
+
+	return nil
+}
+
+func (r *IndexerCheckpointRepository) DeleteBeforeBlockNumber(
+	ctx context.Context,
+	opts relayer.DeleteIndexerCheckpointsOpts,
+) error {
+	r.delete(opts, func(c *relayer.IndexerCheckpoint) bool { return c.BlockNumber < opts.BlockNumber })
+
+	return nil
+}
+
+func (r *IndexerCheckpointRepository) delete(
+	opts relayer.DeleteIndexerCheckpointsOpts,
+	match func(c *relayer.IndexerCheckpoint) bool,
+) {
+	checkpoints := make([]*relayer.IndexerCheckpoint, 0)
+
+	for _, c := range r.checkpoints {
+		if c.ChainID == opts.ChainID && c.DestChainID == opts.DestChainID &&
+			c.EventName == opts.EventName && match(c) {
+			continue
+		}
+
+		checkpoints = append(checkpoints, c)
+	}
+
+	r.checkpoints = checkpoints
+}
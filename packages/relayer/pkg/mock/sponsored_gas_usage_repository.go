@@ -0,0 +1,61 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+type SponsoredGasUsageRepository struct {
+	usages []*relayer.SponsoredGasUsage
+}
+
+func NewSponsoredGasUsageRepository() *SponsoredGasUsageRepository {
+	return &SponsoredGasUsageRepository{
+		usages: make([]*relayer.SponsoredGasUsage, 0),
+	}
+}
+
+func (r *SponsoredGasUsageRepository) Add(
+	ctx context.Context,
+	opts relayer.AddSponsoredGasUsageOpts,
+) error {
+	for _, u := range r.usages {
+		if u.Policy == opts.Policy && u.Address == opts.Address && u.Day == opts.Day {
+			u.GasUsed += opts.GasUsed
+
+			return nil
+		}
+	}
+
+	r.usages = append(r.usages, &relayer.SponsoredGasUsage{
+		ID:      len(r.usages) + 1,
+		Policy:  opts.Policy,
+		Address: opts.Address,
+		Day:     opts.Day,
+		GasUsed: opts.GasUsed,
+	})
+
+	return nil
+}
+
+func (r *SponsoredGasUsageRepository) GasUsed(
+	ctx context.Context,
+	opts relayer.FindSponsoredGasUsageOpts,
+) (uint64, error) {
+	var gasUsed uint64
+
+	for _, u := range r.usages {
+		if u.Policy != opts.Policy || u.Day != opts.Day {
+			continue
+		}
+
+		if opts.Address != nil && u.Address != *opts.Address {
+			continue
+		}
+
+		gasUsed += u.GasUsed
+	}
+
+	return gasUsed, nil
+}
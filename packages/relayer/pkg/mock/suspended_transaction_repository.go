@@ -0,0 +1,87 @@
+package mock
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+
+	"github.com/morkid/paginate"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"gorm.io/gorm"
+)
+
+type SuspendedTransactionRepository struct {
+	suspendedTxs []*relayer.SuspendedTransaction
+}
+
+func NewSuspendedTransactionRepository() *SuspendedTransactionRepository {
+	return &SuspendedTransactionRepository{
+		suspendedTxs: make([]*relayer.SuspendedTransaction, 0),
+	}
+}
+
+func (r *SuspendedTransactionRepository) Suspend(
+	ctx context.Context,
+	opts relayer.SuspendTransactionOpts,
+) (*relayer.SuspendedTransaction, error) {
+	for _, s := range r.suspendedTxs {
+		if s.MsgHash == opts.MsgHash {
+			s.Suspended = true
+
+			return s, nil
+		}
+	}
+
+	s := &relayer.SuspendedTransaction{
+		ID:           rand.Int(), // nolint: gosec
+		MessageID:    opts.MessageID,
+		SrcChainID:   opts.SrcChainID,
+		DestChainID:  opts.DestChainID,
+		Suspended:    true,
+		MsgHash:      opts.MsgHash,
+		MessageOwner: opts.MessageOwner,
+	}
+
+	r.suspendedTxs = append(r.suspendedTxs, s)
+
+	return s, nil
+}
+
+func (r *SuspendedTransactionRepository) Unsuspend(ctx context.Context, msgHash string) error {
+	for _, s := range r.suspendedTxs {
+		if s.MsgHash == msgHash {
+			s.Suspended = false
+
+			return nil
+		}
+	}
+
+	return gorm.ErrRecordNotFound
+}
+
+func (r *SuspendedTransactionRepository) IsSuspended(ctx context.Context, msgHash string) (bool, error) {
+	for _, s := range r.suspendedTxs {
+		if s.MsgHash == msgHash {
+			return s.Suspended, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (r *SuspendedTransactionRepository) FindAllSuspended(
+	ctx context.Context,
+	req *http.Request,
+) (*paginate.Page, error) {
+	suspendedTxs := &[]relayer.SuspendedTransaction{}
+
+	for _, s := range r.suspendedTxs {
+		if s.Suspended {
+			*suspendedTxs = append(*suspendedTxs, *s)
+		}
+	}
+
+	return &paginate.Page{
+		Items: suspendedTxs,
+	}, nil
+}
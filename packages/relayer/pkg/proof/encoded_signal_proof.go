@@ -2,18 +2,31 @@ package proof
 
 import (
 	"context"
+	"log/slog"
 	"math/big"
 
 	"github.com/taikoxyz/taiko-mono/packages/relayer"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 )
 
+var (
+	// the kinds of chain data a SignalService syncs, as defined in LibSignals.
+	signalRootKind = crypto.Keccak256Hash([]byte("SIGNAL_ROOT"))
+	stateRootKind  = crypto.Keccak256Hash([]byte("STATE_ROOT"))
+)
+
 type HopParams struct {
-	ChainID              *big.Int
+	// ChainID is the chain this hop proves to, which is the chain the next hop is
+	// generated on, or the destination chain for the last hop.
+	ChainID *big.Int
+	// SrcChainID is the chain this hop is generated on, whose root it proves against.
+	SrcChainID           *big.Int
 	SignalServiceAddress common.Address
 	SignalService        relayer.SignalService
 	Key                  [32]byte
@@ -22,21 +35,47 @@ type HopParams struct {
 	BlockNumber          uint64
 }
 
+// EncodedSignalProofWithHops generates and encodes a proof for every hop. If
+// destSignalService is set, it is checked for roots of the intermediate chains which
+// it has cached already, in which case the hops after them are skipped.
 func (p *Prover) EncodedSignalProofWithHops(
 	ctx context.Context,
+	destSignalService relayer.SignalService,
 	hopParams []HopParams,
 ) ([]byte, error) {
 	return p.abiEncodeSignalProofWithHops(ctx,
+		destSignalService,
 		hopParams,
 	)
 }
 
 func (p *Prover) abiEncodeSignalProofWithHops(ctx context.Context,
+	destSignalService relayer.SignalService,
 	hopParams []HopParams,
 ) ([]byte, error) {
 	hopProofs := []encoding.HopProof{}
 
-	for _, hop := range hopParams {
+	for i, hop := range hopParams {
+		// the last hop is proven against a root the destination chain has synced
+		// already, so only the ones before it can be shortened.
+		if destSignalService != nil && i < len(hopParams)-1 {
+			hopProof, err := p.cachedRootHopProof(
+				ctx,
+				destSignalService,
+				hop,
+				hopParams[len(hopParams)-1].ChainID,
+			)
+			if err != nil {
+				return nil, errors.Wrap(err, "p.cachedRootHopProof")
+			}
+
+			if hopProof != nil {
+				hopProofs = append(hopProofs, *hopProof)
+
+				break
+			}
+		}
+
 		block, err := hop.Blocker.BlockByNumber(
 			ctx,
 			new(big.Int).SetUint64(hop.BlockNumber),
@@ -60,7 +99,7 @@ func (p *Prover) abiEncodeSignalProofWithHops(ctx context.Context,
 			BlockID:      block.NumberU64(),
 			ChainID:      hop.ChainID.Uint64(),
 			RootHash:     block.Root(),
-			CacheOption:  encoding.CACHE_NOTHING,
+			CacheOption:  uint8(p.cacheOption),
 			AccountProof: ethProof.AccountProof,
 			StorageProof: ethProof.StorageProof[0].Proof,
 		},
@@ -75,6 +114,90 @@ func (p *Prover) abiEncodeSignalProofWithHops(ctx context.Context,
 	return encodedSignalProof, nil
 }
 
+// cachedRootHopProof returns a proof of hop against a root of its chain the
+// destination SignalService has cached, at or after the hop's block, proving
+// directly to destChainID. A cached signal root is preferred, as it needs no
+// account proof. It returns nil if neither kind of root is cached.
+func (p *Prover) cachedRootHopProof(
+	ctx context.Context,
+	destSignalService relayer.SignalService,
+	hop HopParams,
+	destChainID *big.Int,
+) (*encoding.HopProof, error) {
+	if hop.SrcChainID == nil {
+		return nil, nil
+	}
+
+	for _, kind := range [][32]byte{signalRootKind, stateRootKind} {
+		// a blockId of 0 returns the latest root synced of this kind.
+		synced, err := destSignalService.GetSyncedChainData(
+			&bind.CallOpts{Context: ctx},
+			hop.SrcChainID.Uint64(),
+			kind,
+			0,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "destSignalService.GetSyncedChainData")
+		}
+
+		// signals are never removed, so any root from the hop's block onwards includes it.
+		if synced.ChainData == [32]byte{} || synced.BlockId < hop.BlockNumber {
+			continue
+		}
+
+		ethProof, err := p.getProof(
+			ctx,
+			hop.Caller,
+			hop.SignalServiceAddress,
+			common.Bytes2Hex(hop.Key[:]),
+			int64(synced.BlockId),
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "hop p.getProof")
+		}
+
+		hopProof := &encoding.HopProof{
+			BlockID:      synced.BlockId,
+			ChainID:      destChainID.Uint64(),
+			RootHash:     synced.ChainData,
+			CacheOption:  encoding.CACHE_NOTHING,
+			StorageProof: ethProof.StorageProof[0].Proof,
+		}
+
+		if kind == signalRootKind {
+			// a signal root is the storage root of the SignalService, so the storage
+			// proof is verified against it directly.
+			if ethProof.StorageHash != synced.ChainData {
+				continue
+			}
+
+			hopProof.AccountProof = [][]byte{}
+		} else {
+			block, err := hop.Blocker.BlockByNumber(ctx, new(big.Int).SetUint64(synced.BlockId))
+			if err != nil {
+				return nil, errors.Wrap(err, "hop.Blocker.BlockByNumber")
+			}
+
+			if block.Root() != synced.ChainData {
+				continue
+			}
+
+			hopProof.AccountProof = ethProof.AccountProof
+		}
+
+		slog.Info("proving hop against cached root",
+			"srcChainId", hop.SrcChainID.Uint64(),
+			"destChainId", destChainID.Uint64(),
+			"blockId", synced.BlockId,
+			"signalRoot", kind == signalRootKind,
+		)
+
+		return hopProof, nil
+	}
+
+	return nil, nil
+}
+
 // getProof rlp and abi encodes a proof for SignalService,
 // where `proof` is an rlp and abi encoded (bytes, bytes) consisting of storageProof.Proofs[0]
 // response from `eth_getProof`, and returns the storageHash to be used as the signalRoot.
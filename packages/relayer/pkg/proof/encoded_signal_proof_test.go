@@ -2,17 +2,22 @@ package proof
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
 )
 
 var (
 	// nolint: lll
-	wantEncoded = "0x0000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000028c59000000000000000000000000000000000000000000000000000000000000000a1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000e000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"
+	wantEncoded = "0x0000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000028c59000000000000000000000000000000000000000000000000000000000000000a1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347000000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000e000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"
 )
 
 func Test_EncodedSignalProof(t *testing.T) {
@@ -32,6 +37,7 @@ func Test_EncodedSignalProof(t *testing.T) {
 
 	encoded, err := p.EncodedSignalProofWithHops(
 		context.Background(),
+		nil,
 		hops,
 	)
 
@@ -39,3 +45,144 @@ func Test_EncodedSignalProof(t *testing.T) {
 
 	assert.Equal(t, wantEncoded, hexutil.Encode(encoded))
 }
+
+var cachedSignalRoot = common.HexToHash("0xabcd")
+
+// cachingSignalService is a destination SignalService which has cached the given
+// roots, keyed by kind.
+type cachingSignalService struct {
+	mock.SignalService
+	blockID uint64
+	roots   map[[32]byte][32]byte
+}
+
+func (s *cachingSignalService) GetSyncedChainData(
+	opts *bind.CallOpts,
+	_chainId uint64,
+	_kind [32]byte,
+	_blockId uint64,
+) (struct {
+	BlockId   uint64
+	ChainData [32]byte
+}, error) {
+	return struct {
+		BlockId   uint64
+		ChainData [32]byte
+	}{
+		BlockId:   s.blockID,
+		ChainData: s.roots[_kind],
+	}, nil
+}
+
+// proofCaller returns an eth_getProof response whose storage root is cachedSignalRoot.
+type proofCaller struct{}
+
+func (c *proofCaller) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	return json.Unmarshal([]byte(fmt.Sprintf(
+		`{"storageHash": "%v", "accountProof": ["0x01"], "storageProof": [{"value": "0x01", "proof": ["0x02"]}]}`,
+		cachedSignalRoot.Hex(),
+	)), result)
+}
+
+func testHops() []HopParams {
+	return []HopParams{
+		{
+			ChainID:     big.NewInt(2),
+			SrcChainID:  big.NewInt(1),
+			Key:         [32]byte{0x01},
+			Blocker:     &mock.Blocker{},
+			Caller:      &proofCaller{},
+			BlockNumber: 10,
+		},
+		{
+			ChainID:     big.NewInt(3),
+			SrcChainID:  big.NewInt(2),
+			Key:         [32]byte{0x02},
+			Blocker:     &mock.Blocker{},
+			Caller:      &proofCaller{},
+			BlockNumber: 20,
+		},
+	}
+}
+
+func Test_EncodedSignalProof_cachedRoots(t *testing.T) {
+	p := newTestProver()
+
+	uncached, err := p.EncodedSignalProofWithHops(context.Background(), nil, testHops())
+	assert.Nil(t, err)
+
+	signalRootProof, err := encoding.EncodeHopProofs([]encoding.HopProof{{
+		ChainID:      3,
+		BlockID:      12,
+		RootHash:     cachedSignalRoot,
+		CacheOption:  encoding.CACHE_NOTHING,
+		AccountProof: [][]byte{},
+		StorageProof: [][]byte{{0x02}},
+	}})
+	assert.Nil(t, err)
+
+	stateRootProof, err := encoding.EncodeHopProofs([]encoding.HopProof{{
+		ChainID:      3,
+		BlockID:      12,
+		RootHash:     mock.Header.Root,
+		CacheOption:  encoding.CACHE_NOTHING,
+		AccountProof: [][]byte{{0x01}},
+		StorageProof: [][]byte{{0x02}},
+	}})
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name              string
+		destSignalService *cachingSignalService
+		want              []byte
+	}{
+		{
+			"signalRootCached",
+			&cachingSignalService{
+				blockID: 12,
+				roots: map[[32]byte][32]byte{
+					signalRootKind: cachedSignalRoot,
+					stateRootKind:  mock.Header.Root,
+				},
+			},
+			signalRootProof,
+		},
+		{
+			"stateRootCached",
+			&cachingSignalService{
+				blockID: 12,
+				roots:   map[[32]byte][32]byte{stateRootKind: mock.Header.Root},
+			},
+			stateRootProof,
+		},
+		{
+			"cachedBeforeSignal",
+			&cachingSignalService{
+				blockID: 9,
+				roots:   map[[32]byte][32]byte{signalRootKind: cachedSignalRoot},
+			},
+			uncached,
+		},
+		{
+			"cachedRootMismatch",
+			&cachingSignalService{
+				blockID: 12,
+				roots:   map[[32]byte][32]byte{stateRootKind: common.HexToHash("0x1234")},
+			},
+			uncached,
+		},
+		{
+			"nothingCached",
+			&cachingSignalService{},
+			uncached,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := p.EncodedSignalProofWithHops(context.Background(), tt.destSignalService, testHops())
+			assert.Nil(t, err)
+			assert.Equal(t, hexutil.Encode(tt.want), hexutil.Encode(encoded))
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/inprocess"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/rabbitmq"
+)
+
+var (
+	ErrNoQueueHost = errors.New("queue.host is required when queue.type is rabbitmq")
+)
+
+// Open creates the queue.Queue implementation named by queueType.
+func Open(queueType queue.Type, opts queue.NewQueueOpts) (queue.Queue, error) {
+	switch queueType {
+	case queue.RabbitMQ, "":
+		if opts.Host == "" {
+			return nil, ErrNoQueueHost
+		}
+
+		return rabbitmq.NewQueue(opts)
+	case queue.InProcess:
+		return inprocess.NewQueue(opts)
+	default:
+		return nil, fmt.Errorf("invalid queue type %v, must be one of %v", queueType, queue.Types)
+	}
+}
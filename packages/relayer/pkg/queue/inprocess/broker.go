@@ -0,0 +1,460 @@
+package inprocess
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	brokersMu sync.Mutex
+	brokers   = make(map[string]*broker)
+)
+
+// metadataFile is the file, in each queue directory, persisting the queue's settings.
+const metadataFile = "queue.meta"
+
+// metadata is a queue's settings as they are persisted to disk, so that a restarted
+// broker still dead-letters the messages which expire before the queue is declared again.
+type metadata struct {
+	DeadLetterQueue string `json:"deadLetterQueue"`
+}
+
+// envelope is a single message as it is persisted to disk.
+type envelope struct {
+	ID          string                 `json:"id"`
+	Body        []byte                 `json:"body"`
+	Headers     map[string]interface{} `json:"headers,omitempty"`
+	PublishedAt time.Time              `json:"publishedAt"`
+	ExpiresAt   *time.Time             `json:"expiresAt,omitempty"`
+}
+
+// queueState holds the ready and in-flight messages of one named queue.
+type queueState struct {
+	name string
+	// deadLetterQueue is where messages go when they expire, or are negatively
+	// acknowledged without requeue. Empty means they are dropped.
+	deadLetterQueue string
+	ready           []*envelope
+	inFlight        map[string]*envelope
+}
+
+// broker is shared by every Queue opened against the same data directory inside
+// one process, so an indexer and a processor running in the same binary
+// exchange messages through it.
+type broker struct {
+	mu      sync.Mutex
+	dataDir string
+	queues  map[string]*queueState
+	// changed is closed and replaced every time a message becomes ready, waking up
+	// any subscriber waiting for work.
+	changed  chan struct{}
+	refs     int
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// acquireBroker returns the broker for dataDir, creating and loading it
+// from disk if this is the first Queue opened against it.
+func acquireBroker(dataDir string) (*broker, error) {
+	dir, err := filepath.Abs(dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	brokersMu.Lock()
+	defer brokersMu.Unlock()
+
+	if b, ok := brokers[dir]; ok {
+		b.refs++
+
+		return b, nil
+	}
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	b := &broker{
+		dataDir: dir,
+		queues:  make(map[string]*queueState),
+		changed: make(chan struct{}),
+		refs:    1,
+		stopCh:  make(chan struct{}),
+	}
+
+	if err := b.load(); err != nil {
+		return nil, err
+	}
+
+	brokers[dir] = b
+
+	go b.expireLoop()
+
+	return b, nil
+}
+
+// releaseBroker drops a reference to b, stopping it once no Queue uses it.
+func releaseBroker(b *broker) {
+	brokersMu.Lock()
+	defer brokersMu.Unlock()
+
+	b.refs--
+
+	if b.refs > 0 {
+		return
+	}
+
+	delete(brokers, b.dataDir)
+
+	b.stopOnce.Do(func() {
+		close(b.stopCh)
+	})
+}
+
+// load reads every persisted message back into the ready list of its queue.
+// Messages that were in flight when the process stopped were never acknowledged,
+// so they are redelivered, the same as an unacknowledged RabbitMQ delivery.
+func (b *broker) load() error {
+	entries, err := os.ReadDir(b.dataDir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		q := b.declareLocked(entry.Name(), "")
+
+		if data, err := os.ReadFile(filepath.Join(b.queueDir(q.name), metadataFile)); err == nil {
+			m := &metadata{}
+			if err := json.Unmarshal(data, m); err != nil {
+				return err
+			}
+
+			q.deadLetterQueue = m.DeadLetterQueue
+		} else if !os.IsNotExist(err) {
+			return err
+		}
+
+		files, err := os.ReadDir(filepath.Join(b.dataDir, entry.Name()))
+		if err != nil {
+			return err
+		}
+
+		for _, f := range files {
+			if f.IsDir() || !strings.HasSuffix(f.Name(), ".json") {
+				continue
+			}
+
+			path := filepath.Join(b.dataDir, entry.Name(), f.Name())
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+
+			env := &envelope{}
+			if err := json.Unmarshal(data, env); err != nil {
+				slog.Error("skipping corrupt in-process queue message", "path", path, "err", err.Error())
+
+				continue
+			}
+
+			q.ready = append(q.ready, env)
+		}
+
+		sort.Slice(q.ready, func(i, j int) bool {
+			return q.ready[i].ID < q.ready[j].ID
+		})
+
+		slog.Info("loaded in-process queue from disk", "queue", q.name, "messages", len(q.ready))
+	}
+
+	return nil
+}
+
+// declare makes sure a queue exists, and sets and persists its dead letter queue
+// if one is given.
+func (b *broker) declare(name string, deadLetterQueue string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.declareLocked(name, deadLetterQueue)
+
+	if err := os.MkdirAll(b.queueDir(name), 0o750); err != nil {
+		return err
+	}
+
+	if deadLetterQueue == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(&metadata{DeadLetterQueue: deadLetterQueue})
+	if err != nil {
+		return err
+	}
+
+	return b.writeFile(name, filepath.Join(b.queueDir(name), metadataFile), data)
+}
+
+func (b *broker) declareLocked(name string, deadLetterQueue string) *queueState {
+	q, ok := b.queues[name]
+	if !ok {
+		q = &queueState{
+			name:     name,
+			inFlight: make(map[string]*envelope),
+		}
+
+		b.queues[name] = q
+	}
+
+	if deadLetterQueue != "" {
+		q.deadLetterQueue = deadLetterQueue
+	}
+
+	return q
+}
+
+func (b *broker) queueDir(name string) string {
+	return filepath.Join(b.dataDir, name)
+}
+
+func (b *broker) messagePath(queueName string, id string) string {
+	return filepath.Join(b.queueDir(queueName), id+".json")
+}
+
+// publish persists a message and appends it to the ready list of the queue.
+func (b *broker) publish(
+	queueName string,
+	body []byte,
+	headers map[string]interface{},
+	expiresAt *time.Time,
+) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	q := b.declareLocked(queueName, "")
+
+	env := &envelope{
+		// the id is prefixed with the publish time so that sorting file names on
+		// load restores the publish order.
+		ID:          fmt.Sprintf("%020d-%v", time.Now().UnixNano(), uuid.New().String()),
+		Body:        body,
+		Headers:     headers,
+		PublishedAt: time.Now().UTC(),
+		ExpiresAt:   expiresAt,
+	}
+
+	if err := b.write(queueName, env); err != nil {
+		return err
+	}
+
+	q.ready = append(q.ready, env)
+
+	b.notifyLocked()
+
+	return nil
+}
+
+// write persists env atomically.
+func (b *broker) write(queueName string, env *envelope) error {
+	data, err := json.Marshal(env)
+	if err != nil {
+		return err
+	}
+
+	return b.writeFile(queueName, b.messagePath(queueName, env.ID), data)
+}
+
+// writeFile writes data to path in a queue's directory atomically, by writing to a
+// temporary file and renaming it.
+func (b *broker) writeFile(queueName string, path string, data []byte) error {
+	if err := os.MkdirAll(b.queueDir(queueName), 0o750); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(b.queueDir(queueName), ".tmp-*")
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+
+		return err
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (b *broker) remove(queueName string, id string) error {
+	if err := os.Remove(b.messagePath(queueName, id)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// next pops the first ready message of a queue and marks it in flight, or returns
+// nil and a channel which is closed once there might be work.
+func (b *broker) next(queueName string) (*envelope, <-chan struct{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	q := b.declareLocked(queueName, "")
+
+	if len(q.ready) == 0 {
+		return nil, b.changed
+	}
+
+	env := q.ready[0]
+	q.ready = q.ready[1:]
+	q.inFlight[env.ID] = env
+
+	return env, nil
+}
+
+// ack removes an in-flight message for good.
+func (b *broker) ack(queueName string, id string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	q := b.declareLocked(queueName, "")
+
+	if _, ok := q.inFlight[id]; !ok {
+		return ErrUnknownDelivery
+	}
+
+	delete(q.inFlight, id)
+
+	return b.remove(queueName, id)
+}
+
+// nack either puts an in-flight message back at the head of its queue, or
+// dead-letters it.
+func (b *broker) nack(queueName string, id string, requeue bool) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	q := b.declareLocked(queueName, "")
+
+	env, ok := q.inFlight[id]
+	if !ok {
+		return ErrUnknownDelivery
+	}
+
+	delete(q.inFlight, id)
+
+	if requeue {
+		q.ready = append([]*envelope{env}, q.ready...)
+
+		b.notifyLocked()
+
+		return nil
+	}
+
+	return b.deadLetterLocked(q, env)
+}
+
+// deadLetterLocked moves env out of q into q's dead letter queue, clearing its
+// expiration like RabbitMQ does, or drops it if q has none.
+func (b *broker) deadLetterLocked(q *queueState, env *envelope) error {
+	if q.deadLetterQueue == "" {
+		slog.Info("dropping in-process queue message with no dead letter queue", "queue", q.name, "msgId", env.ID)
+
+		return b.remove(q.name, env.ID)
+	}
+
+	dlq := b.declareLocked(q.deadLetterQueue, "")
+
+	moved := &envelope{
+		ID:          env.ID,
+		Body:        env.Body,
+		Headers:     env.Headers,
+		PublishedAt: env.PublishedAt,
+	}
+
+	if err := b.write(dlq.name, moved); err != nil {
+		return err
+	}
+
+	if err := b.remove(q.name, env.ID); err != nil {
+		return err
+	}
+
+	dlq.ready = append(dlq.ready, moved)
+
+	b.notifyLocked()
+
+	return nil
+}
+
+// expire dead-letters every ready message whose expiration has passed.
+func (b *broker) expire(now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, q := range b.queues {
+		remaining := make([]*envelope, 0, len(q.ready))
+
+		for _, env := range q.ready {
+			if env.ExpiresAt == nil || env.ExpiresAt.After(now) {
+				remaining = append(remaining, env)
+
+				continue
+			}
+
+			if err := b.deadLetterLocked(q, env); err != nil {
+				slog.Error("error dead-lettering expired in-process queue message", "queue", q.name, "err", err.Error())
+
+				remaining = append(remaining, env)
+			}
+		}
+
+		q.ready = remaining
+	}
+}
+
+func (b *broker) expireLoop() {
+	t := time.NewTicker(expirationCheckInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-b.stopCh:
+			return
+		case now := <-t.C:
+			b.expire(now)
+		}
+	}
+}
+
+func (b *broker) notifyLocked() {
+	close(b.changed)
+	b.changed = make(chan struct{})
+}
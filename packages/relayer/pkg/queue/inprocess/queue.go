@@ -0,0 +1,301 @@
+package inprocess
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+var (
+	ErrNoDataDir         = errors.New("in-process queue requires a data directory")
+	ErrUnknownDelivery   = errors.New("unknown or already acknowledged delivery")
+	ErrInvalidExpiration = errors.New("invalid expiration, must be a number of milliseconds")
+)
+
+var expirationCheckInterval = 250 * time.Millisecond
+
+// delivery is what is stored in queue.Message.Internal, identifying which
+// message an Ack or Nack refers to.
+type delivery struct {
+	queueName string
+	id        string
+}
+
+// InProcess is a queue.Queue which runs inside the relayer process rather than
+// against a broker. Messages are persisted to disk under opts.DataDir, so they
+// survive restarts, and every InProcess opened against the same directory in one
+// process shares the same queues. It mirrors the topology the RabbitMQ implementation
+// declares: messages negatively acknowledged without requeue go to "dlx-<queue>",
+// and messages published with an expiration to "<queue>-unprofitable" are moved
+// back to the main queue once they expire.
+type InProcess struct {
+	broker    *broker
+	opts      queue.NewQueueOpts
+	queueName string
+
+	// inFlight counts deliveries to subscribers of this queue which have not been
+	// acknowledged yet, to honour opts.PrefetchCount.
+	inFlightMu sync.Mutex
+	inFlight   uint64
+	// acked is signalled when an in-flight delivery is acknowledged, so a subscriber
+	// blocked on the prefetch limit can continue.
+	acked chan struct{}
+
+	closeOnce sync.Once
+}
+
+func NewQueue(opts queue.NewQueueOpts) (*InProcess, error) {
+	if opts.DataDir == "" {
+		relayer.QueueConnectionInstantiatedErrors.Inc()
+
+		return nil, ErrNoDataDir
+	}
+
+	slog.Info("opening in-process queue", "dataDir", opts.DataDir)
+
+	b, err := acquireBroker(opts.DataDir)
+	if err != nil {
+		relayer.QueueConnectionInstantiatedErrors.Inc()
+
+		return nil, err
+	}
+
+	relayer.QueueConnectionInstantiated.Inc()
+
+	return &InProcess{
+		broker: b,
+		opts:   opts,
+		acked:  make(chan struct{}, 1),
+	}, nil
+}
+
+func (q *InProcess) Start(ctx context.Context, queueName string) error {
+	dlxQueue := fmt.Sprintf("dlx-%v", queueName)
+
+	unprofitableQueue := fmt.Sprintf("%v-unprofitable", queueName)
+
+	slog.Info("declaring in-process queues", "queue", queueName)
+
+	// messages which expire, or are rejected without requeue, from the main queue are
+	// dead-lettered to the dlx queue, and back to the main queue from there.
+	if err := q.broker.declare(queueName, dlxQueue); err != nil {
+		return err
+	}
+
+	if err := q.broker.declare(dlxQueue, queueName); err != nil {
+		return err
+	}
+
+	// unprofitable messages are published with an expiration, and are checked
+	// again in the normal processing flow once it has passed.
+	if err := q.broker.declare(unprofitableQueue, queueName); err != nil {
+		return err
+	}
+
+	q.queueName = queueName
+
+	return nil
+}
+
+func (q *InProcess) Close(ctx context.Context) {
+	q.closeOnce.Do(func() {
+		releaseBroker(q.broker)
+
+		slog.Info("closed in-process queue")
+	})
+}
+
+func (q *InProcess) Publish(
+	ctx context.Context,
+	queueName string,
+	msg []byte,
+	headers map[string]interface{},
+	expiration *string,
+) error {
+	slog.Info("publishing in-process msg to queue", "queue", queueName)
+
+	var expiresAt *time.Time
+
+	// expiration uses the same format as an AMQP message, a number of milliseconds.
+	if expiration != nil && *expiration != "" {
+		ms, err := strconv.ParseUint(*expiration, 10, 64)
+		if err != nil {
+			relayer.QueueMessagePublishedErrors.Inc()
+
+			return ErrInvalidExpiration
+		}
+
+		t := time.Now().Add(time.Duration(ms) * time.Millisecond)
+		expiresAt = &t
+	}
+
+	if err := q.broker.publish(queueName, msg, headers, expiresAt); err != nil {
+		relayer.QueueMessagePublishedErrors.Inc()
+
+		return err
+	}
+
+	relayer.QueueMessagePublished.Inc()
+
+	return nil
+}
+
+func (q *InProcess) Ack(ctx context.Context, msg queue.Message) error {
+	d, ok := msg.Internal.(delivery)
+	if !ok {
+		return ErrUnknownDelivery
+	}
+
+	if err := q.broker.ack(d.queueName, d.id); err != nil {
+		slog.Error("error acknowledging in-process message", "err", err.Error())
+		return err
+	}
+
+	q.release()
+
+	slog.Info("acknowledged in-process message", "msgId", d.id)
+
+	relayer.QueueMessageAcknowledged.Inc()
+
+	return nil
+}
+
+func (q *InProcess) Nack(ctx context.Context, msg queue.Message, requeue bool) error {
+	d, ok := msg.Internal.(delivery)
+	if !ok {
+		return ErrUnknownDelivery
+	}
+
+	if err := q.broker.nack(d.queueName, d.id, requeue); err != nil {
+		slog.Error("error negatively acknowledging in-process message", "err", err.Error())
+		return err
+	}
+
+	q.release()
+
+	slog.Info("negatively acknowledged in-process message", "msgId", d.id, "requeue", requeue)
+
+	relayer.QueueMessageNegativelyAcknowledged.Inc()
+
+	return nil
+}
+
+// Notify should be called by publishers who wish to be notified of subscription errors.
+// There is no connection which can be lost, so it only returns once ctx is done.
+func (q *InProcess) Notify(ctx context.Context, wg *sync.WaitGroup) error {
+	wg.Add(1)
+
+	defer func() {
+		wg.Done()
+	}()
+
+	slog.Info("in-process queue notify running")
+
+	<-ctx.Done()
+
+	slog.Info("in-process queue context closed")
+
+	return nil
+}
+
+// Subscribe should be called by consumers. It delivers messages from the queue passed
+// to Start, never holding more than opts.PrefetchCount unacknowledged ones, until
+// ctx is done.
+func (q *InProcess) Subscribe(ctx context.Context, msgChan chan<- queue.Message, wg *sync.WaitGroup) error {
+	wg.Add(1)
+
+	defer func() {
+		wg.Done()
+	}()
+
+	if q.queueName == "" {
+		return queue.ErrClosed
+	}
+
+	slog.Info("subscribing to in-process messages", "queue", q.queueName)
+
+	for {
+		if !q.acquire() {
+			select {
+			case <-ctx.Done():
+				slog.Info("in-process queue context cancelled")
+
+				return nil
+			case <-q.acked:
+			}
+
+			continue
+		}
+
+		env, changed := q.broker.next(q.queueName)
+		if env == nil {
+			q.release()
+
+			select {
+			case <-ctx.Done():
+				slog.Info("in-process queue context cancelled")
+
+				return nil
+			case <-changed:
+			}
+
+			continue
+		}
+
+		slog.Info("in-process message found", "msgId", env.ID)
+
+		select {
+		case <-ctx.Done():
+			// hand the message back so it is delivered again next time.
+			if err := q.broker.nack(q.queueName, env.ID, true); err != nil {
+				slog.Error("error requeueing in-process message", "err", err.Error())
+			}
+
+			q.release()
+
+			slog.Info("in-process queue context cancelled")
+
+			return nil
+		case msgChan <- queue.Message{
+			Body:     env.Body,
+			Internal: delivery{queueName: q.queueName, id: env.ID},
+		}:
+		}
+	}
+}
+
+// acquire reserves an in-flight slot, returning false if the prefetch limit
+// has been reached. A PrefetchCount of 0 means no limit, as with RabbitMQ.
+func (q *InProcess) acquire() bool {
+	q.inFlightMu.Lock()
+	defer q.inFlightMu.Unlock()
+
+	if q.opts.PrefetchCount != 0 && q.inFlight >= q.opts.PrefetchCount {
+		return false
+	}
+
+	q.inFlight++
+
+	return true
+}
+
+func (q *InProcess) release() {
+	q.inFlightMu.Lock()
+	defer q.inFlightMu.Unlock()
+
+	if q.inFlight > 0 {
+		q.inFlight--
+	}
+
+	select {
+	case q.acked <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,303 @@
+package inprocess
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+var testQueueName = "1-2-MessageSent-queue"
+
+func newTestQueue(t *testing.T, dataDir string, prefetch uint64) *InProcess {
+	q, err := NewQueue(queue.NewQueueOpts{
+		DataDir:       dataDir,
+		PrefetchCount: prefetch,
+	})
+	assert.Nil(t, err)
+
+	assert.Nil(t, q.Start(context.Background(), testQueueName))
+
+	return q
+}
+
+// subscribe starts a subscription in the background, returning the channel
+// messages are delivered on.
+func subscribe(t *testing.T, ctx context.Context, q *InProcess) chan queue.Message {
+	msgs := make(chan queue.Message)
+
+	wg := &sync.WaitGroup{}
+
+	go func() {
+		assert.Nil(t, q.Subscribe(ctx, msgs, wg))
+	}()
+
+	return msgs
+}
+
+func receive(t *testing.T, msgs chan queue.Message) queue.Message {
+	select {
+	case m := <-msgs:
+		return m
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for message")
+	}
+
+	return queue.Message{}
+}
+
+func assertNoMessage(t *testing.T, msgs chan queue.Message, wait time.Duration) {
+	select {
+	case m := <-msgs:
+		t.Fatalf("unexpected message %v", string(m.Body))
+	case <-time.After(wait):
+	}
+}
+
+func Test_NewQueue(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    queue.NewQueueOpts
+		wantErr error
+	}{
+		{
+			"success",
+			queue.NewQueueOpts{DataDir: t.TempDir()},
+			nil,
+		},
+		{
+			"noDataDir",
+			queue.NewQueueOpts{},
+			ErrNoDataDir,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := NewQueue(tt.opts)
+			assert.Equal(t, tt.wantErr, err)
+
+			if q != nil {
+				q.Close(context.Background())
+			}
+		})
+	}
+}
+
+func Test_PublishSubscribeAck(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	q := newTestQueue(t, t.TempDir(), 0)
+	defer q.Close(ctx)
+
+	assert.Nil(t, q.Publish(ctx, testQueueName, []byte("first"), nil, nil))
+	assert.Nil(t, q.Publish(ctx, testQueueName, []byte("second"), nil, nil))
+
+	msgs := subscribe(t, ctx, q)
+
+	m := receive(t, msgs)
+	assert.Equal(t, "first", string(m.Body))
+	assert.Nil(t, q.Ack(ctx, m))
+
+	m = receive(t, msgs)
+	assert.Equal(t, "second", string(m.Body))
+	assert.Nil(t, q.Ack(ctx, m))
+
+	assert.Equal(t, ErrUnknownDelivery, q.Ack(ctx, m))
+}
+
+func Test_NackRequeue(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	q := newTestQueue(t, t.TempDir(), 0)
+	defer q.Close(ctx)
+
+	assert.Nil(t, q.Publish(ctx, testQueueName, []byte("msg"), nil, nil))
+
+	msgs := subscribe(t, ctx, q)
+
+	m := receive(t, msgs)
+	assert.Nil(t, q.Nack(ctx, m, true))
+
+	m = receive(t, msgs)
+	assert.Equal(t, "msg", string(m.Body))
+	assert.Nil(t, q.Ack(ctx, m))
+}
+
+func Test_NackNoRequeueDeadLetters(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	q := newTestQueue(t, t.TempDir(), 0)
+	defer q.Close(ctx)
+
+	assert.Nil(t, q.Publish(ctx, testQueueName, []byte("msg"), nil, nil))
+
+	msgs := subscribe(t, ctx, q)
+
+	m := receive(t, msgs)
+	assert.Nil(t, q.Nack(ctx, m, false))
+
+	assertNoMessage(t, msgs, 100*time.Millisecond)
+
+	q.broker.mu.Lock()
+	dlq := q.broker.queues["dlx-"+testQueueName]
+	assert.Equal(t, 1, len(dlq.ready))
+	assert.Equal(t, "msg", string(dlq.ready[0].Body))
+	q.broker.mu.Unlock()
+}
+
+func Test_ExpiredUnprofitableMessageIsRedelivered(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	q := newTestQueue(t, t.TempDir(), 0)
+	defer q.Close(ctx)
+
+	expiration := "300"
+
+	assert.Nil(t, q.Publish(ctx, testQueueName+"-unprofitable", []byte("unprofitable"), nil, &expiration))
+
+	msgs := subscribe(t, ctx, q)
+
+	assertNoMessage(t, msgs, 100*time.Millisecond)
+
+	m := receive(t, msgs)
+	assert.Equal(t, "unprofitable", string(m.Body))
+	assert.Nil(t, q.Ack(ctx, m))
+}
+
+func Test_PublishInvalidExpiration(t *testing.T) {
+	q := newTestQueue(t, t.TempDir(), 0)
+	defer q.Close(context.Background())
+
+	expiration := "1h"
+
+	assert.Equal(
+		t,
+		ErrInvalidExpiration,
+		q.Publish(context.Background(), testQueueName, []byte("msg"), nil, &expiration),
+	)
+}
+
+func Test_Prefetch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	q := newTestQueue(t, t.TempDir(), 1)
+	defer q.Close(ctx)
+
+	assert.Nil(t, q.Publish(ctx, testQueueName, []byte("first"), nil, nil))
+	assert.Nil(t, q.Publish(ctx, testQueueName, []byte("second"), nil, nil))
+
+	msgs := subscribe(t, ctx, q)
+
+	m := receive(t, msgs)
+	assert.Equal(t, "first", string(m.Body))
+
+	// the second message is held back until the first is acknowledged.
+	assertNoMessage(t, msgs, 100*time.Millisecond)
+
+	assert.Nil(t, q.Ack(ctx, m))
+
+	m = receive(t, msgs)
+	assert.Equal(t, "second", string(m.Body))
+	assert.Nil(t, q.Ack(ctx, m))
+}
+
+func Test_SharedBetweenQueuesInProcess(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dataDir := t.TempDir()
+
+	publisher := newTestQueue(t, dataDir, 0)
+	defer publisher.Close(ctx)
+
+	consumer := newTestQueue(t, dataDir, 0)
+	defer consumer.Close(ctx)
+
+	msgs := subscribe(t, ctx, consumer)
+
+	assert.Nil(t, publisher.Publish(ctx, testQueueName, []byte("msg"), nil, nil))
+
+	m := receive(t, msgs)
+	assert.Equal(t, "msg", string(m.Body))
+	assert.Nil(t, consumer.Ack(ctx, m))
+}
+
+func Test_PersistsAcrossRestarts(t *testing.T) {
+	dataDir := t.TempDir()
+
+	q := newTestQueue(t, dataDir, 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	assert.Nil(t, q.Publish(ctx, testQueueName, []byte("acked"), nil, nil))
+	assert.Nil(t, q.Publish(ctx, testQueueName, []byte("unacked"), nil, nil))
+
+	msgs := subscribe(t, ctx, q)
+
+	m := receive(t, msgs)
+	assert.Equal(t, "acked", string(m.Body))
+	assert.Nil(t, q.Ack(ctx, m))
+
+	// received but never acknowledged before the restart.
+	m = receive(t, msgs)
+	assert.Equal(t, "unacked", string(m.Body))
+
+	cancel()
+	q.Close(context.Background())
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+
+	q = newTestQueue(t, dataDir, 0)
+	defer q.Close(ctx)
+
+	msgs = subscribe(t, ctx, q)
+
+	m = receive(t, msgs)
+	assert.Equal(t, "unacked", string(m.Body))
+	assert.Nil(t, q.Ack(ctx, m))
+
+	assertNoMessage(t, msgs, 100*time.Millisecond)
+}
+
+func Test_DeadLetterQueuePersistsAcrossRestarts(t *testing.T) {
+	dataDir := t.TempDir()
+
+	q := newTestQueue(t, dataDir, 0)
+
+	expiration := "60000"
+
+	assert.Nil(t, q.Publish(
+		context.Background(),
+		testQueueName+"-unprofitable",
+		[]byte("unprofitable"),
+		nil,
+		&expiration,
+	))
+
+	q.Close(context.Background())
+
+	// the restarted broker expires the message before any queue is declared again.
+	b, err := acquireBroker(dataDir)
+	assert.Nil(t, err)
+
+	defer releaseBroker(b)
+
+	b.expire(time.Now().Add(time.Hour))
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	assert.Equal(t, 0, len(b.queues[testQueueName+"-unprofitable"].ready))
+	assert.Equal(t, 1, len(b.queues[testQueueName].ready))
+	assert.Equal(t, "unprofitable", string(b.queues[testQueueName].ready[0].Body))
+}
@@ -3,6 +3,8 @@ package queue
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math/big"
 	"sync"
 
 	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
@@ -12,6 +14,24 @@ var (
 	ErrClosed = errors.New("queue connection closed")
 )
 
+// Type is the name of a queue.Queue implementation, selected with the queue.type flag.
+type Type string
+
+const (
+	// RabbitMQ connects to a RabbitMQ broker.
+	RabbitMQ Type = "rabbitmq"
+	// InProcess runs the queue inside the relayer process, persisted to a data directory.
+	InProcess Type = "inprocess"
+)
+
+var Types = []Type{RabbitMQ, InProcess}
+
+// Name builds out the name of the queue the indexer publishes the given event's messages
+// to, for the route from srcChainID to destChainID, and the processor or watchdog listens on.
+func Name(srcChainID *big.Int, destChainID *big.Int, eventName string) string {
+	return fmt.Sprintf("%v-%v-%v-queue", srcChainID.String(), destChainID.String(), eventName)
+}
+
 type Queue interface {
 	Start(ctx context.Context, queueName string) error
 	Close(ctx context.Context)
@@ -32,10 +52,13 @@ type QueueMessageSentBody struct {
 	Event        *bridge.BridgeMessageSent
 	ID           int
 	TimesRetried uint64
+	// LastError is the error the message failed with the last time it was retried.
+	LastError string
 }
 
 type QueueMessageProcessedBody struct {
 	Message bridge.IBridgeMessage
+	MsgHash [32]byte
 	ID      int
 }
 
@@ -50,4 +73,6 @@ type NewQueueOpts struct {
 	Host          string
 	Port          string
 	PrefetchCount uint64
+	// DataDir is where the in-process queue persists its messages.
+	DataDir string
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
@@ -21,6 +22,35 @@ var (
 	dbPassword = "password"
 )
 
+// forEachDialect runs f as a subtest against a freshly migrated database of every
+// dialect the repositories support.
+func forEachDialect(t *testing.T, f func(t *testing.T, db db.DB)) {
+	for _, dialect := range db.Dialects {
+		t.Run(string(dialect), func(t *testing.T) {
+			var (
+				d     db.DB
+				close func()
+				err   error
+			)
+
+			switch dialect {
+			case db.MySQL:
+				d, close, err = testMysql(t)
+			case db.Postgres:
+				d, close, err = testPostgres(t)
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer close()
+
+			f(t, d)
+		})
+	}
+}
+
 func testMysql(t *testing.T) (db.DB, func(), error) {
 	req := testcontainers.ContainerRequest{
 		Image:        "mysql:latest",
@@ -75,3 +105,59 @@ func testMysql(t *testing.T) (db.DB, func(), error) {
 
 	return db.New(gormDB), closeContainer, nil
 }
+
+func testPostgres(t *testing.T) (db.DB, func(), error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "postgres:16",
+		ExposedPorts: []string{"5432/tcp"},
+		Env: map[string]string{
+			"POSTGRES_PASSWORD": dbPassword,
+			"POSTGRES_DB":       dbName,
+		},
+		// postgres restarts once after initializing the database.
+		WaitingFor: wait.ForLog("database system is ready to accept connections").WithOccurrence(2),
+	}
+
+	ctx := context.Background()
+
+	postgresC, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	closeContainer := func() {
+		err := postgresC.Terminate(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	host, _ := postgresC.Host(ctx)
+	p, _ := postgresC.MappedPort(ctx, "5432/tcp")
+	port := p.Int()
+
+	dsn := fmt.Sprintf("host=%s port=%d user=postgres password=%s dbname=%s sslmode=disable",
+		host, port, dbPassword, dbName)
+
+	gormDB, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := goose.SetDialect("postgres"); err != nil {
+		t.Fatal(err)
+	}
+
+	sqlDB, _ := gormDB.DB()
+	if err := goose.Up(sqlDB, "../../migrations/postgres"); err != nil {
+		t.Fatal(err)
+	}
+
+	return db.New(gormDB), closeContainer, nil
+}
@@ -0,0 +1,100 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/datatypes"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+)
+
+type DeadLetterMessageRepository struct {
+	db db.DB
+}
+
+func NewDeadLetterMessageRepository(dbHandler db.DB) (*DeadLetterMessageRepository, error) {
+	if dbHandler == nil {
+		return nil, db.ErrNoDB
+	}
+
+	return &DeadLetterMessageRepository{
+		db: dbHandler,
+	}, nil
+}
+
+func (r *DeadLetterMessageRepository) Save(
+	ctx context.Context,
+	opts relayer.SaveDeadLetterMessageOpts,
+) (*relayer.DeadLetterMessage, error) {
+	m := &relayer.DeadLetterMessage{
+		MessageID:    opts.MessageID,
+		SrcChainID:   opts.SrcChainID,
+		DestChainID:  opts.DestChainID,
+		MsgHash:      opts.MsgHash,
+		TimesRetried: opts.TimesRetried,
+		LastError:    opts.LastError,
+		Body:         datatypes.JSON(opts.Body),
+	}
+
+	if err := r.db.GormDB().WithContext(ctx).Create(m).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Create")
+	}
+
+	return m, nil
+}
+
+// Find returns every dead-lettered message which matches opts, oldest first.
+func (r *DeadLetterMessageRepository) Find(
+	ctx context.Context,
+	opts relayer.FindDeadLetterMessagesOpts,
+) ([]*relayer.DeadLetterMessage, error) {
+	q := r.db.GormDB().WithContext(ctx).
+		Model(&relayer.DeadLetterMessage{})
+
+	if !opts.IncludeReplayed {
+		q = q.Where("replayed = ?", false)
+	}
+
+	if opts.MsgHash != nil {
+		q = q.Where("msg_hash = ?", *opts.MsgHash)
+	}
+
+	if opts.SrcChainID != nil {
+		q = q.Where("src_chain_id = ?", *opts.SrcChainID)
+	}
+
+	if opts.Since != nil {
+		q = q.Where("created_at >= ?", *opts.Since)
+	}
+
+	if opts.Until != nil {
+		q = q.Where("created_at <= ?", *opts.Until)
+	}
+
+	var msgs []*relayer.DeadLetterMessage
+
+	if err := q.Order("id ASC").Find(&msgs).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return msgs, nil
+}
+
+// MarkReplayed records that the dead-lettered message has been put back on the queue,
+// so it is not replayed again.
+func (r *DeadLetterMessageRepository) MarkReplayed(ctx context.Context, id int) error {
+	if err := r.db.GormDB().WithContext(ctx).
+		Model(&relayer.DeadLetterMessage{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"replayed":    true,
+			"replayed_at": time.Now().UTC(),
+		}).Error; err != nil {
+		return errors.Wrap(err, "r.db.Updates")
+	}
+
+	return nil
+}
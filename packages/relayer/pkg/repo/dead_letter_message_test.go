@@ -0,0 +1,108 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"gopkg.in/go-playground/assert.v1"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+)
+
+func Test_NewDeadLetterMessageRepo(t *testing.T) {
+	tests := []struct {
+		name    string
+		db      db.DB
+		wantErr error
+	}{
+		{
+			"success",
+			&db.Database{},
+			nil,
+		},
+		{
+			"noDb",
+			nil,
+			db.ErrNoDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewDeadLetterMessageRepository(tt.db)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestIntegration_DeadLetterMessage_SaveFindAndMarkReplayed(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, db db.DB) {
+		deadLetterRepo, err := NewDeadLetterMessageRepository(db)
+		assert.Equal(t, nil, err)
+
+		for _, opts := range []relayer.SaveDeadLetterMessageOpts{
+			{
+				MessageID:    1,
+				SrcChainID:   1,
+				DestChainID:  2,
+				MsgHash:      testMsgHash,
+				TimesRetried: 5,
+				LastError:    "ERR_UNPROFITABLE",
+				Body:         []byte(`{"ID":1}`),
+			},
+			{
+				MessageID:    2,
+				SrcChainID:   2,
+				DestChainID:  1,
+				MsgHash:      testSecondMsgHash,
+				TimesRetried: 5,
+				LastError:    "execution reverted",
+				Body:         []byte(`{"ID":2}`),
+			},
+		} {
+			_, err := deadLetterRepo.Save(context.Background(), opts)
+			assert.Equal(t, nil, err)
+		}
+
+		all, err := deadLetterRepo.Find(context.Background(), relayer.FindDeadLetterMessagesOpts{})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 2, len(all))
+
+		srcChainID := int64(2)
+
+		byChain, err := deadLetterRepo.Find(context.Background(), relayer.FindDeadLetterMessagesOpts{
+			SrcChainID: &srcChainID,
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 1, len(byChain))
+		assert.Equal(t, testSecondMsgHash, byChain[0].MsgHash)
+		assert.Equal(t, "execution reverted", byChain[0].LastError)
+
+		msgHash := testMsgHash
+
+		byMsgHash, err := deadLetterRepo.Find(context.Background(), relayer.FindDeadLetterMessagesOpts{
+			MsgHash: &msgHash,
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 1, len(byMsgHash))
+
+		assert.Equal(t, nil, deadLetterRepo.MarkReplayed(context.Background(), byMsgHash[0].ID))
+
+		// replayed messages are not returned again.
+		byMsgHash, err = deadLetterRepo.Find(context.Background(), relayer.FindDeadLetterMessagesOpts{
+			MsgHash: &msgHash,
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 0, len(byMsgHash))
+
+		// unless they are asked for.
+		byMsgHash, err = deadLetterRepo.Find(context.Background(), relayer.FindDeadLetterMessagesOpts{
+			MsgHash:         &msgHash,
+			IncludeReplayed: true,
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 1, len(byMsgHash))
+		assert.Equal(t, true, byMsgHash[0].Replayed)
+	})
+}
@@ -30,12 +30,7 @@ func NewEventRepository(dbHandler db.DB) (*EventRepository, error) {
 
 // Close closes the database connection.
 func (r *EventRepository) Close() error {
-	sqlDB, err := r.db.DB()
-	if err != nil {
-		return err
-	}
-
-	return sqlDB.Close()
+	return r.db.Close()
 }
 
 func (r *EventRepository) Save(ctx context.Context, opts *relayer.SaveEventOpts) (*relayer.Event, error) {
@@ -105,6 +100,27 @@ func (r *EventRepository) UpdateFeesAndProfitability(
 	return nil
 }
 
+// UpdateProcessingGasUsed records the gas the processMessage transaction of the event
+// with id used, which FindProcessingCosts samples.
+func (r *EventRepository) UpdateProcessingGasUsed(
+	ctx context.Context,
+	id int,
+	opts *relayer.UpdateProcessingGasUsedOpts,
+) error {
+	err := r.db.GormDB().WithContext(ctx).
+		Model(&relayer.Event{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"processing_gas_used": opts.GasUsed,
+			"processed_at":        opts.ProcessedAt,
+		}).Error
+	if err != nil {
+		return errors.Wrap(err, "r.db.Updates")
+	}
+
+	return nil
+}
+
 func (r *EventRepository) UpdateStatus(ctx context.Context, id int, status relayer.EventStatus) error {
 	tx := r.db.GormDB().WithContext(ctx)
 	tx = tx.Model(&relayer.Event{})
@@ -165,6 +181,24 @@ func (r *EventRepository) FirstByEventAndMsgHash(
 	return e, nil
 }
 
+// FindAllByMsgHash returns every event of the message with msgHash, in the order
+// they were indexed.
+func (r *EventRepository) FindAllByMsgHash(
+	ctx context.Context,
+	msgHash string,
+) ([]*relayer.Event, error) {
+	var events []*relayer.Event
+
+	if err := r.db.GormDB().WithContext(ctx).
+		Where("msg_hash = ?", msgHash).
+		Order("id ASC").
+		Find(&events).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return events, nil
+}
+
 func (r *EventRepository) FindAllByAddress(
 	ctx context.Context,
 	req *http.Request,
@@ -176,10 +210,12 @@ func (r *EventRepository) FindAllByAddress(
 
 	q := r.db.GormDB().WithContext(ctx).
 		Model(&relayer.Event{}).
+		// message_owner is saved checksummed, and unlike mysql, postgres compares
+		// strings case-sensitively, so both forms are matched.
 		Where(
-			"dest_owner_json = ? OR message_owner = ?",
-			strings.ToLower(opts.Address.Hex()),
+			"dest_owner_json = ? OR message_owner IN ?",
 			strings.ToLower(opts.Address.Hex()),
+			[]string{strings.ToLower(opts.Address.Hex()), opts.Address.Hex()},
 		)
 
 	if opts.EventType != nil {
@@ -262,13 +298,15 @@ func (r *EventRepository) LatestChainDataSyncedEvent(
 	return uint64(blockID), nil
 }
 
-// DeleteAllAfterBlockID is used when a reorg is detected
-func (r *EventRepository) DeleteAllAfterBlockID(blockID uint64, srcChainID uint64, destChainID uint64) error {
+// DeleteAllAfterBlockID is used when a reorg is detected, and deletes the events
+// emitted in blocks after opts.BlockID.
+func (r *EventRepository) DeleteAllAfterBlockID(ctx context.Context, opts relayer.DeleteAllAfterBlockIDOpts) error {
 	query := `
 DELETE FROM events
-WHERE block_id >= ? AND chain_id = ? AND dest_chain_id = ?`
+WHERE emitted_block_id > ? AND chain_id = ? AND dest_chain_id = ? AND event IN ?`
 
-	return r.db.GormDB().Table("events").Exec(query, blockID, srcChainID, destChainID).Error
+	return r.db.GormDB().WithContext(ctx).Table("events").
+		Exec(query, opts.BlockID, opts.SrcChainID, opts.DestChainID, opts.Events).Error
 }
 
 // GetLatestBlockID get latest block id
@@ -290,3 +328,87 @@ func (r *EventRepository) FindLatestBlockID(
 
 	return b, nil
 }
+
+// FindProcessingCosts returns the processing cost of every MessageSent event to
+// destChainID processed by the relayer since opts.Since. A message is counted as
+// deploying its bridged token when no earlier message bridged the same canonical
+// token between the same chains, which events_canonical_token_index keeps cheap
+// to look up.
+func (r *EventRepository) FindProcessingCosts(
+	ctx context.Context,
+	opts relayer.FindProcessingCostsOpts,
+) ([]relayer.ProcessingCost, error) {
+	q := `SELECT e.event_type, e.processing_gas_used,
+	CASE WHEN EXISTS (
+		SELECT 1 FROM events p
+		WHERE p.event = e.event AND p.event_type = e.event_type
+		AND p.chain_id = e.chain_id AND p.dest_chain_id = e.dest_chain_id
+		AND p.canonical_token_address = e.canonical_token_address AND p.id < e.id
+	) THEN 1 ELSE 0 END AS deployed
+	FROM events e
+	WHERE e.event = ? AND e.dest_chain_id = ? AND e.status = ? AND e.processed_at >= ?
+	AND e.processing_gas_used > 0
+	ORDER BY e.id`
+
+	var rows []struct {
+		EventType         relayer.EventType
+		ProcessingGasUsed uint64
+		Deployed          int
+	}
+
+	if err := r.db.GormDB().WithContext(ctx).
+		Raw(q, relayer.EventNameMessageSent, opts.DestChainID, relayer.EventStatusDone, opts.Since).
+		Scan(&rows).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Raw")
+	}
+
+	costs := make([]relayer.ProcessingCost, 0, len(rows))
+
+	for _, row := range rows {
+		costs = append(costs, relayer.ProcessingCost{
+			EventType: row.EventType,
+			Deployed:  row.Deployed == 1,
+			GasUsed:   row.ProcessingGasUsed,
+		})
+	}
+
+	return costs, nil
+}
+
+// FindLatestID returns the ID of the last event indexed, or 0 if there are none.
+func (r *EventRepository) FindLatestID(ctx context.Context) (int, error) {
+	var id int
+
+	if err := r.db.GormDB().WithContext(ctx).Table("events").
+		Select("COALESCE(MAX(id), 0)").
+		Scan(&id).Error; err != nil {
+		return 0, errors.Wrap(err, "r.db.Scan")
+	}
+
+	return id, nil
+}
+
+// FindAllAfterID returns the events indexed after the event with opts.ID, oldest first.
+func (r *EventRepository) FindAllAfterID(
+	ctx context.Context,
+	opts relayer.FindAllAfterIDOpts,
+) ([]*relayer.Event, error) {
+	q := r.db.GormDB().WithContext(ctx).
+		Where("id > ?", opts.ID)
+
+	if len(opts.Events) > 0 {
+		q = q.Where("event IN ?", opts.Events)
+	}
+
+	if opts.Limit > 0 {
+		q = q.Limit(opts.Limit)
+	}
+
+	var events []*relayer.Event
+
+	if err := q.Order("id ASC").Find(&events).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return events, nil
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/morkid/paginate"
@@ -110,399 +111,562 @@ func Test_NewEventRepo(t *testing.T) {
 }
 
 func TestIntegration_Event_Save(t *testing.T) {
-	db, close, err := testMysql(t)
-	assert.Equal(t, nil, err)
-
-	defer close()
-
-	eventRepo, err := NewEventRepository(db)
-	assert.Equal(t, nil, err)
-	tests := []struct {
-		name    string
-		opts    *relayer.SaveEventOpts
-		wantErr error
-	}{
-		{
-			"success",
-			&relayer.SaveEventOpts{
-				Name:                   "test",
-				ChainID:                big.NewInt(1),
-				DestChainID:            big.NewInt(2),
-				Data:                   "{\"data\":\"something\"}",
-				EventType:              relayer.EventType(relayer.EventTypeSendETH),
-				CanonicalTokenAddress:  "0x1",
-				CanonicalTokenSymbol:   "ETH",
-				CanonicalTokenName:     "Ethereum",
-				CanonicalTokenDecimals: 18,
-				Amount:                 "1",
-				MsgHash:                "0x1",
-				MessageOwner:           "0x1",
-				Event:                  relayer.EventNameMessageSent,
-				EmittedBlockID:         1,
+	forEachDialect(t, func(t *testing.T, db db.DB) {
+		eventRepo, err := NewEventRepository(db)
+		assert.Equal(t, nil, err)
+		tests := []struct {
+			name    string
+			opts    *relayer.SaveEventOpts
+			wantErr error
+		}{
+			{
+				"success",
+				&relayer.SaveEventOpts{
+					Name:                   "test",
+					ChainID:                big.NewInt(1),
+					DestChainID:            big.NewInt(2),
+					Data:                   "{\"data\":\"something\"}",
+					EventType:              relayer.EventType(relayer.EventTypeSendETH),
+					CanonicalTokenAddress:  "0x1",
+					CanonicalTokenSymbol:   "ETH",
+					CanonicalTokenName:     "Ethereum",
+					CanonicalTokenDecimals: 18,
+					Amount:                 "1",
+					MsgHash:                "0x1",
+					MessageOwner:           "0x1",
+					Event:                  relayer.EventNameMessageSent,
+					EmittedBlockID:         1,
+				},
+				nil,
 			},
-			nil,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			_, err = eventRepo.Save(context.Background(), tt.opts)
-			assert.Equal(t, tt.wantErr, err)
-		})
-	}
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err = eventRepo.Save(context.Background(), tt.opts)
+				assert.Equal(t, tt.wantErr, err)
+			})
+		}
+	})
 }
 
 func TestIntegration_Event_UpdateStatus(t *testing.T) {
-	db, close, err := testMysql(t)
-	assert.Equal(t, nil, err)
-
-	defer close()
-
-	eventRepo, err := NewEventRepository(db)
-	assert.Equal(t, nil, err)
-
-	tests := []struct {
-		name    string
-		id      int
-		status  relayer.EventStatus
-		wantErr bool
-	}{
-		{
-			"success",
-			1,
-			relayer.EventStatusDone,
-			false,
-		},
-		{
-			"errNotFound",
-			123,
-			relayer.EventStatusDone,
-			true,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if tt.name == "success" {
-				_, err := eventRepo.Save(context.Background(),
-					&relayer.SaveEventOpts{
-						Name:                   "test",
-						ChainID:                big.NewInt(1),
-						DestChainID:            big.NewInt(2),
-						Data:                   "{\"data\":\"something\"}",
-						EventType:              relayer.EventTypeSendETH,
-						CanonicalTokenAddress:  "0x1",
-						CanonicalTokenSymbol:   "ETH",
-						CanonicalTokenName:     "Ethereum",
-						CanonicalTokenDecimals: 18,
-						Amount:                 "1",
-						MsgHash:                "0x1",
-						MessageOwner:           "0x1",
-						Event:                  relayer.EventNameMessageSent,
-						EmittedBlockID:         1,
-					},
-				)
-				assert.Equal(t, nil, err)
-			}
-
-			err := eventRepo.UpdateStatus(context.Background(), tt.id, tt.status)
-
-			assert.Equal(t, tt.wantErr, err != nil)
-		})
-	}
+	forEachDialect(t, func(t *testing.T, db db.DB) {
+		eventRepo, err := NewEventRepository(db)
+		assert.Equal(t, nil, err)
+
+		tests := []struct {
+			name    string
+			id      int
+			status  relayer.EventStatus
+			wantErr bool
+		}{
+			{
+				"success",
+				1,
+				relayer.EventStatusDone,
+				false,
+			},
+			{
+				"errNotFound",
+				123,
+				relayer.EventStatusDone,
+				true,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if tt.name == "success" {
+					_, err := eventRepo.Save(context.Background(),
+						&relayer.SaveEventOpts{
+							Name:                   "test",
+							ChainID:                big.NewInt(1),
+							DestChainID:            big.NewInt(2),
+							Data:                   "{\"data\":\"something\"}",
+							EventType:              relayer.EventTypeSendETH,
+							CanonicalTokenAddress:  "0x1",
+							CanonicalTokenSymbol:   "ETH",
+							CanonicalTokenName:     "Ethereum",
+							CanonicalTokenDecimals: 18,
+							Amount:                 "1",
+							MsgHash:                "0x1",
+							MessageOwner:           "0x1",
+							Event:                  relayer.EventNameMessageSent,
+							EmittedBlockID:         1,
+						},
+					)
+					assert.Equal(t, nil, err)
+				}
+
+				err := eventRepo.UpdateStatus(context.Background(), tt.id, tt.status)
+
+				assert.Equal(t, tt.wantErr, err != nil)
+			})
+		}
+	})
 }
 
 func TestIntegration_Event_FindAllByAddress(t *testing.T) {
-	db, close, err := testMysql(t)
-	assert.Equal(t, nil, err)
-
-	defer close()
-
-	eventRepo, err := NewEventRepository(db)
-
-	assert.Equal(t, nil, err)
-
-	addr := common.HexToAddress("0x71C7656EC7ab88b098defB751B7401B5f6d8976F")
-
-	_, err = eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
-		Name:                   "name",
-		Data:                   fmt.Sprintf(`{"Message": {"Owner": "%s"}}`, strings.ToLower(addr.Hex())),
-		ChainID:                big.NewInt(1),
-		DestChainID:            big.NewInt(2),
-		Status:                 relayer.EventStatusDone,
-		EventType:              relayer.EventTypeSendETH,
-		CanonicalTokenAddress:  "0x1",
-		CanonicalTokenSymbol:   "ETH",
-		CanonicalTokenName:     "Ethereum",
-		CanonicalTokenDecimals: 18,
-		Amount:                 "1",
-		MsgHash:                "0x1",
-		MessageOwner:           addr.Hex(),
-		Event:                  relayer.EventNameMessageSent,
-		EmittedBlockID:         1,
-	})
-
-	assert.Equal(t, nil, err)
-
-	_, err = eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
-		Name:                   "name",
-		Data:                   fmt.Sprintf(`{"Message": {"Owner": "%s"}}`, strings.ToLower(addr.Hex())),
-		ChainID:                big.NewInt(1),
-		DestChainID:            big.NewInt(2),
-		Status:                 relayer.EventStatusDone,
-		EventType:              relayer.EventTypeSendERC20,
-		CanonicalTokenAddress:  "0x1",
-		CanonicalTokenSymbol:   "FAKE",
-		CanonicalTokenName:     "Fake Token",
-		CanonicalTokenDecimals: 18,
-		Amount:                 "1",
-		MsgHash:                "0x1",
-		MessageOwner:           addr.Hex(),
-		Event:                  relayer.EventNameMessageSent,
-		EmittedBlockID:         1,
-	})
-	assert.Equal(t, nil, err)
-
-	_, err = eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
-		Name:                   "name",
-		Data:                   fmt.Sprintf(`{"Message": {"Owner": "%s"}}`, strings.ToLower(addr.Hex())),
-		ChainID:                big.NewInt(1),
-		DestChainID:            big.NewInt(2),
-		Status:                 relayer.EventStatusDone,
-		EventType:              relayer.EventTypeSendERC20,
-		CanonicalTokenAddress:  "0x2",
-		CanonicalTokenSymbol:   "FAKE",
-		CanonicalTokenName:     "Fake Token",
-		CanonicalTokenDecimals: 18,
-		Amount:                 "1",
-		MsgHash:                "0x2",
-		MessageOwner:           addr.Hex(),
-		Event:                  relayer.EventNameMessageStatusChanged,
-		EmittedBlockID:         1,
-	})
-	assert.Equal(t, nil, err)
+	forEachDialect(t, func(t *testing.T, db db.DB) {
+		eventRepo, err := NewEventRepository(db)
+
+		assert.Equal(t, nil, err)
+
+		addr := common.HexToAddress("0x71C7656EC7ab88b098defB751B7401B5f6d8976F")
+
+		_, err = eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+			Name:                   "name",
+			Data:                   fmt.Sprintf(`{"Message": {"Owner": "%s"}}`, strings.ToLower(addr.Hex())),
+			ChainID:                big.NewInt(1),
+			DestChainID:            big.NewInt(2),
+			Status:                 relayer.EventStatusDone,
+			EventType:              relayer.EventTypeSendETH,
+			CanonicalTokenAddress:  "0x1",
+			CanonicalTokenSymbol:   "ETH",
+			CanonicalTokenName:     "Ethereum",
+			CanonicalTokenDecimals: 18,
+			Amount:                 "1",
+			MsgHash:                "0x1",
+			MessageOwner:           addr.Hex(),
+			Event:                  relayer.EventNameMessageSent,
+			EmittedBlockID:         1,
+		})
 
-	tests := []struct {
-		name     string
-		opts     relayer.FindAllByAddressOpts
-		wantResp paginate.Page
-		wantErr  error
-	}{
-		{
-			"successJustAddress",
-			relayer.FindAllByAddressOpts{
-				Address: addr,
-			},
-			paginate.Page{
-				Items:      testEvents,
-				Page:       0,
-				Size:       100,
-				MaxPage:    1,
-				TotalPages: 1,
-				Total:      1,
-				Last:       false,
-				First:      true,
-				Visible:    1,
-			},
-			nil,
-		},
-		{
-			"successJustAddressAndEvent",
-			relayer.FindAllByAddressOpts{
-				Address: addr,
-				Event:   &relayer.EventNameMessageSent,
-			},
-			paginate.Page{
-				Items:      testEvents[:2],
-				Page:       0,
-				Size:       100,
-				MaxPage:    1,
-				TotalPages: 1,
-				Total:      1,
-				Last:       false,
-				First:      true,
-				Visible:    1,
-			},
-			nil,
-		},
-		{
-			"successAddressAndMsgHash",
-			relayer.FindAllByAddressOpts{
-				Address: addr,
-				MsgHash: &testMsgHash,
-			},
-			paginate.Page{
-				Items:      testEvents[:2],
-				Page:       0,
-				Size:       100,
-				MaxPage:    1,
-				TotalPages: 1,
-				Total:      1,
-				Last:       false,
-				First:      true,
-				Visible:    1,
+		assert.Equal(t, nil, err)
+
+		_, err = eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+			Name:                   "name",
+			Data:                   fmt.Sprintf(`{"Message": {"Owner": "%s"}}`, strings.ToLower(addr.Hex())),
+			ChainID:                big.NewInt(1),
+			DestChainID:            big.NewInt(2),
+			Status:                 relayer.EventStatusDone,
+			EventType:              relayer.EventTypeSendERC20,
+			CanonicalTokenAddress:  "0x1",
+			CanonicalTokenSymbol:   "FAKE",
+			CanonicalTokenName:     "Fake Token",
+			CanonicalTokenDecimals: 18,
+			Amount:                 "1",
+			MsgHash:                "0x1",
+			MessageOwner:           addr.Hex(),
+			Event:                  relayer.EventNameMessageSent,
+			EmittedBlockID:         1,
+		})
+		assert.Equal(t, nil, err)
+
+		_, err = eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+			Name:                   "name",
+			Data:                   fmt.Sprintf(`{"Message": {"Owner": "%s"}}`, strings.ToLower(addr.Hex())),
+			ChainID:                big.NewInt(1),
+			DestChainID:            big.NewInt(2),
+			Status:                 relayer.EventStatusDone,
+			EventType:              relayer.EventTypeSendERC20,
+			CanonicalTokenAddress:  "0x2",
+			CanonicalTokenSymbol:   "FAKE",
+			CanonicalTokenName:     "Fake Token",
+			CanonicalTokenDecimals: 18,
+			Amount:                 "1",
+			MsgHash:                "0x2",
+			MessageOwner:           addr.Hex(),
+			Event:                  relayer.EventNameMessageStatusChanged,
+			EmittedBlockID:         1,
+		})
+		assert.Equal(t, nil, err)
+
+		tests := []struct {
+			name     string
+			opts     relayer.FindAllByAddressOpts
+			wantResp paginate.Page
+			wantErr  error
+		}{
+			{
+				"successJustAddress",
+				relayer.FindAllByAddressOpts{
+					Address: addr,
+				},
+				paginate.Page{
+					Items:      testEvents,
+					Page:       0,
+					Size:       100,
+					MaxPage:    1,
+					TotalPages: 1,
+					Total:      1,
+					Last:       false,
+					First:      true,
+					Visible:    1,
+				},
+				nil,
 			},
-			nil,
-		},
-		{
-			"successAddressAndEventType",
-			relayer.FindAllByAddressOpts{
-				Address:   addr,
-				EventType: &testEventTypeSendERC20,
+			{
+				"successJustAddressAndEvent",
+				relayer.FindAllByAddressOpts{
+					Address: addr,
+					Event:   &relayer.EventNameMessageSent,
+				},
+				paginate.Page{
+					Items:      testEvents[:2],
+					Page:       0,
+					Size:       100,
+					MaxPage:    1,
+					TotalPages: 1,
+					Total:      1,
+					Last:       false,
+					First:      true,
+					Visible:    1,
+				},
+				nil,
 			},
-			paginate.Page{
-				Items:      testEvents[1:3],
-				Page:       0,
-				Size:       100,
-				MaxPage:    1,
-				TotalPages: 1,
-				Total:      1,
-				Last:       false,
-				First:      true,
-				Visible:    1,
+			{
+				"successAddressAndMsgHash",
+				relayer.FindAllByAddressOpts{
+					Address: addr,
+					MsgHash: &testMsgHash,
+				},
+				paginate.Page{
+					Items:      testEvents[:2],
+					Page:       0,
+					Size:       100,
+					MaxPage:    1,
+					TotalPages: 1,
+					Total:      1,
+					Last:       false,
+					First:      true,
+					Visible:    1,
+				},
+				nil,
 			},
-			nil,
-		},
-		{
-			"successAddressMsgHashAndEventType",
-			relayer.FindAllByAddressOpts{
-				Address:   addr,
-				EventType: &testEventTypeSendERC20,
-				MsgHash:   &testSecondMsgHash,
+			{
+				"successAddressAndEventType",
+				relayer.FindAllByAddressOpts{
+					Address:   addr,
+					EventType: &testEventTypeSendERC20,
+				},
+				paginate.Page{
+					Items:      testEvents[1:3],
+					Page:       0,
+					Size:       100,
+					MaxPage:    1,
+					TotalPages: 1,
+					Total:      1,
+					Last:       false,
+					First:      true,
+					Visible:    1,
+				},
+				nil,
 			},
-			paginate.Page{
-				Items:      testEvents[2:],
-				Page:       0,
-				Size:       100,
-				MaxPage:    1,
-				TotalPages: 1,
-				Total:      1,
-				Last:       false,
-				First:      true,
-				Visible:    1,
+			{
+				"successAddressMsgHashAndEventType",
+				relayer.FindAllByAddressOpts{
+					Address:   addr,
+					EventType: &testEventTypeSendERC20,
+					MsgHash:   &testSecondMsgHash,
+				},
+				paginate.Page{
+					Items:      testEvents[2:],
+					Page:       0,
+					Size:       100,
+					MaxPage:    1,
+					TotalPages: 1,
+					Total:      1,
+					Last:       false,
+					First:      true,
+					Visible:    1,
+				},
+				nil,
 			},
-			nil,
-		},
-		{
-			"successAddressMsgHashAndEvent",
-			relayer.FindAllByAddressOpts{
-				Address: addr,
-				MsgHash: &testSecondMsgHash,
-				Event:   &relayer.EventNameMessageStatusChanged,
+			{
+				"successAddressMsgHashAndEvent",
+				relayer.FindAllByAddressOpts{
+					Address: addr,
+					MsgHash: &testSecondMsgHash,
+					Event:   &relayer.EventNameMessageStatusChanged,
+				},
+				paginate.Page{
+					Items:      testEvents[2:],
+					Page:       0,
+					Size:       100,
+					MaxPage:    1,
+					TotalPages: 1,
+					Total:      1,
+					Last:       false,
+					First:      true,
+					Visible:    1,
+				},
+				nil,
 			},
-			paginate.Page{
-				Items:      testEvents[2:],
-				Page:       0,
-				Size:       100,
-				MaxPage:    1,
-				TotalPages: 1,
-				Total:      1,
-				Last:       false,
-				First:      true,
-				Visible:    1,
+			{
+				"noneByAddr",
+				relayer.FindAllByAddressOpts{
+					Address: common.HexToAddress("0x165CD37b4C644C2921454429E7F9358d18A45e14"),
+				},
+				paginate.Page{
+					Items:      []relayer.Event{},
+					Page:       0,
+					Size:       100,
+					MaxPage:    1,
+					TotalPages: 1,
+					Total:      1,
+					Last:       true,
+					First:      true,
+					Visible:    1,
+				},
+				nil,
 			},
-			nil,
-		},
-		{
-			"noneByAddr",
-			relayer.FindAllByAddressOpts{
-				Address: common.HexToAddress("0x165CD37b4C644C2921454429E7F9358d18A45e14"),
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				req, err := http.NewRequest(http.MethodGet, "/events", nil)
+				assert.Equal(t, nil, err)
+
+				resp, err := eventRepo.FindAllByAddress(context.Background(), req, tt.opts)
+				assert.Equal(t, tt.wantResp.Items, resp.Items)
+				assert.Equal(t, tt.wantErr, err)
+			})
+		}
+	})
+}
+
+func TestIntegration_Event_FirstByMsgHash(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, db db.DB) {
+		eventRepo, err := NewEventRepository(db)
+		assert.Equal(t, nil, err)
+
+		_, err = eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+			Name:                   "name",
+			Data:                   fmt.Sprintf(`{"Message": {"Owner": "%s"}}`, strings.ToLower(addr.Hex())),
+			ChainID:                big.NewInt(1),
+			DestChainID:            big.NewInt(2),
+			Status:                 relayer.EventStatusDone,
+			EventType:              relayer.EventTypeSendETH,
+			CanonicalTokenAddress:  "0x1",
+			CanonicalTokenSymbol:   "ETH",
+			CanonicalTokenName:     "Ethereum",
+			CanonicalTokenDecimals: 18,
+			Amount:                 "1",
+			MsgHash:                "0x1",
+			MessageOwner:           addr.Hex(),
+			EmittedBlockID:         1,
+		})
+		assert.Equal(t, nil, err)
+		tests := []struct {
+			name     string
+			msgHash  string
+			wantResp *relayer.Event
+			wantErr  error
+		}{
+			{
+				"success",
+				"0x1",
+				&relayer.Event{
+					ID:   1,
+					Name: "name",
+					// nolint lll
+					Data:                   datatypes.JSON([]byte(fmt.Sprintf(`{"Message": {"Owner": "%s"}}`, strings.ToLower(addr.Hex())))),
+					ChainID:                1,
+					DestChainID:            2,
+					Status:                 relayer.EventStatusDone,
+					EventType:              relayer.EventTypeSendETH,
+					CanonicalTokenAddress:  "0x1",
+					CanonicalTokenSymbol:   "ETH",
+					CanonicalTokenName:     "Ethereum",
+					CanonicalTokenDecimals: 18,
+					Amount:                 "1",
+					MsgHash:                "0x1",
+					MessageOwner:           addr.Hex(),
+					EmittedBlockID:         1,
+				},
+				nil,
 			},
-			paginate.Page{
-				Items:      []relayer.Event{},
-				Page:       0,
-				Size:       100,
-				MaxPage:    1,
-				TotalPages: 1,
-				Total:      1,
-				Last:       true,
-				First:      true,
-				Visible:    1,
+			{
+				"noneByMgHash",
+				"0xfake",
+				nil,
+				nil,
 			},
-			nil,
-		},
-	}
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				resp, err := eventRepo.FirstByMsgHash(context.Background(), tt.msgHash)
+				assert.Equal(t, tt.wantResp, resp)
+				assert.Equal(t, tt.wantErr, err)
+			})
+		}
+	})
+}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			req, err := http.NewRequest(http.MethodGet, "/events", nil)
+func TestIntegration_Event_FindProcessingCosts(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, db db.DB) {
+		eventRepo, err := NewEventRepository(db)
+		assert.Equal(t, nil, err)
+
+		now := time.Now().UTC()
+
+		// two messages bridging the same token, one processed outside the window, and
+		// one which has not been processed yet.
+		for i, processed := range []struct {
+			at     time.Time
+			status relayer.EventStatus
+		}{
+			{now, relayer.EventStatusDone},
+			{now, relayer.EventStatusDone},
+			{now.Add(-2 * time.Hour), relayer.EventStatusDone},
+			{now, relayer.EventStatusRetriable},
+		} {
+			e, err := eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+				Name:                  relayer.EventNameMessageSent,
+				Data:                  "{}",
+				ChainID:               big.NewInt(1),
+				DestChainID:           big.NewInt(2),
+				Status:                relayer.EventStatusNew,
+				EventType:             relayer.EventTypeSendERC20,
+				CanonicalTokenAddress: "0x1",
+				MsgHash:               fmt.Sprintf("0x%v", i),
+				Event:                 relayer.EventNameMessageSent,
+			})
 			assert.Equal(t, nil, err)
 
-			resp, err := eventRepo.FindAllByAddress(context.Background(), req, tt.opts)
-			assert.Equal(t, tt.wantResp.Items, resp.Items)
-			assert.Equal(t, tt.wantErr, err)
+			err = eventRepo.UpdateProcessingGasUsed(context.Background(), e.ID, &relayer.UpdateProcessingGasUsedOpts{
+				GasUsed:     uint64(100000 * (i + 1)),
+				ProcessedAt: processed.at,
+			})
+			assert.Equal(t, nil, err)
+
+			assert.Equal(t, nil, eventRepo.UpdateStatus(context.Background(), e.ID, processed.status))
+		}
+
+		costs, err := eventRepo.FindProcessingCosts(context.Background(), relayer.FindProcessingCostsOpts{
+			DestChainID: 2,
+			Since:       now.Add(-time.Hour),
 		})
-	}
+		assert.Equal(t, nil, err)
+		assert.Equal(t, []relayer.ProcessingCost{
+			{EventType: relayer.EventTypeSendERC20, Deployed: false, GasUsed: 100000},
+			{EventType: relayer.EventTypeSendERC20, Deployed: true, GasUsed: 200000},
+		}, costs)
+	})
 }
 
-func TestIntegration_Event_FirstByMsgHash(t *testing.T) {
-	db, close, err := testMysql(t)
-	assert.Equal(t, nil, err)
+func TestIntegration_Event_FindAllByMsgHash(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, db db.DB) {
+		eventRepo, err := NewEventRepository(db)
+		assert.Equal(t, nil, err)
+
+		for _, event := range []string{relayer.EventNameMessageSent, relayer.EventNameMessageStatusChanged} {
+			_, err = eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+				Name:        event,
+				Data:        "{}",
+				ChainID:     big.NewInt(1),
+				DestChainID: big.NewInt(2),
+				Status:      relayer.EventStatusNew,
+				MsgHash:     testMsgHash,
+				Event:       event,
+			})
+			assert.Equal(t, nil, err)
+		}
 
-	defer close()
+		events, err := eventRepo.FindAllByMsgHash(context.Background(), testMsgHash)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 2, len(events))
+		assert.Equal(t, relayer.EventNameMessageSent, events[0].Event)
+		assert.Equal(t, relayer.EventNameMessageStatusChanged, events[1].Event)
 
-	eventRepo, err := NewEventRepository(db)
-	assert.Equal(t, nil, err)
+		events, err = eventRepo.FindAllByMsgHash(context.Background(), testSecondMsgHash)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 0, len(events))
+	})
+}
 
-	_, err = eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
-		Name:                   "name",
-		Data:                   fmt.Sprintf(`{"Message": {"Owner": "%s"}}`, strings.ToLower(addr.Hex())),
-		ChainID:                big.NewInt(1),
-		DestChainID:            big.NewInt(2),
-		Status:                 relayer.EventStatusDone,
-		EventType:              relayer.EventTypeSendETH,
-		CanonicalTokenAddress:  "0x1",
-		CanonicalTokenSymbol:   "ETH",
-		CanonicalTokenName:     "Ethereum",
-		CanonicalTokenDecimals: 18,
-		Amount:                 "1",
-		MsgHash:                "0x1",
-		MessageOwner:           addr.Hex(),
-		EmittedBlockID:         1,
+func TestIntegration_Event_FindAllAfterID(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, db db.DB) {
+		eventRepo, err := NewEventRepository(db)
+		assert.Equal(t, nil, err)
+
+		latestID, err := eventRepo.FindLatestID(context.Background())
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 0, latestID)
+
+		for _, event := range []string{
+			relayer.EventNameMessageSent,
+			relayer.EventNameChainDataSynced,
+			relayer.EventNameMessageStatusChanged,
+			relayer.EventNameMessageProcessed,
+		} {
+			_, err = eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+				Name:        event,
+				Data:        "{}",
+				ChainID:     big.NewInt(1),
+				DestChainID: big.NewInt(2),
+				Status:      relayer.EventStatusNew,
+				MsgHash:     testMsgHash,
+				Event:       event,
+			})
+			assert.Equal(t, nil, err)
+		}
+
+		latestID, err = eventRepo.FindLatestID(context.Background())
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 4, latestID)
+
+		events, err := eventRepo.FindAllAfterID(context.Background(), relayer.FindAllAfterIDOpts{
+			ID:     1,
+			Events: []string{relayer.EventNameMessageStatusChanged, relayer.EventNameMessageProcessed},
+			Limit:  1,
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 1, len(events))
+		assert.Equal(t, 3, events[0].ID)
+
+		events, err = eventRepo.FindAllAfterID(context.Background(), relayer.FindAllAfterIDOpts{
+			ID: 3,
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 1, len(events))
+		assert.Equal(t, 4, events[0].ID)
 	})
-	assert.Equal(t, nil, err)
-	tests := []struct {
-		name     string
-		msgHash  string
-		wantResp *relayer.Event
-		wantErr  error
-	}{
-		{
-			"success",
-			"0x1",
-			&relayer.Event{
-				ID:   1,
-				Name: "name",
-				// nolint lll
-				Data:                   datatypes.JSON([]byte(fmt.Sprintf(`{"Message": {"Owner": "%s"}}`, strings.ToLower(addr.Hex())))),
-				ChainID:                1,
-				DestChainID:            2,
-				Status:                 relayer.EventStatusDone,
-				EventType:              relayer.EventTypeSendETH,
-				CanonicalTokenAddress:  "0x1",
-				CanonicalTokenSymbol:   "ETH",
-				CanonicalTokenName:     "Ethereum",
-				CanonicalTokenDecimals: 18,
-				Amount:                 "1",
-				MsgHash:                "0x1",
-				MessageOwner:           addr.Hex(),
-				EmittedBlockID:         1,
-			},
-			nil,
-		},
-		{
-			"noneByMgHash",
-			"0xfake",
-			nil,
-			nil,
-		},
-	}
+}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			resp, err := eventRepo.FirstByMsgHash(context.Background(), tt.msgHash)
-			assert.Equal(t, tt.wantResp, resp)
-			assert.Equal(t, tt.wantErr, err)
+func TestIntegration_Event_DeleteAllAfterBlockID(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, db db.DB) {
+		eventRepo, err := NewEventRepository(db)
+		assert.Equal(t, nil, err)
+
+		for _, opts := range []struct {
+			event          string
+			emittedBlockID uint64
+		}{
+			{relayer.EventNameMessageSent, 1},
+			{relayer.EventNameMessageSent, 2},
+			{relayer.EventNameMessageStatusChanged, 2},
+			{relayer.EventNameMessageProcessed, 2},
+		} {
+			_, err = eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+				Name:           opts.event,
+				Data:           "{}",
+				ChainID:        big.NewInt(1),
+				DestChainID:    big.NewInt(2),
+				Status:         relayer.EventStatusNew,
+				MsgHash:        testMsgHash,
+				Event:          opts.event,
+				EmittedBlockID: opts.emittedBlockID,
+			})
+			assert.Equal(t, nil, err)
+		}
+
+		err = eventRepo.DeleteAllAfterBlockID(context.Background(), relayer.DeleteAllAfterBlockIDOpts{
+			BlockID:     1,
+			SrcChainID:  1,
+			DestChainID: 2,
+			Events:      []string{relayer.EventNameMessageSent, relayer.EventNameMessageStatusChanged},
 		})
-	}
+		assert.Equal(t, nil, err)
+
+		// the MessageProcessed event belongs to another indexer, and should not be deleted.
+		events, err := eventRepo.FindAllByMsgHash(context.Background(), testMsgHash)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 2, len(events))
+		assert.Equal(t, uint64(1), events[0].EmittedBlockID)
+		assert.Equal(t, relayer.EventNameMessageProcessed, events[1].Event)
+	})
 }
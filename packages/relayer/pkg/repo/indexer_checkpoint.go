@@ -0,0 +1,102 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm/clause"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+)
+
+type IndexerCheckpointRepository struct {
+	db db.DB
+}
+
+func NewIndexerCheckpointRepository(dbHandler db.DB) (*IndexerCheckpointRepository, error) {
+	if dbHandler == nil {
+		return nil, db.ErrNoDB
+	}
+
+	return &IndexerCheckpointRepository{
+		db: dbHandler,
+	}, nil
+}
+
+// Save saves a checkpoint, replacing the block hash of the one at the same block
+// number if the block has been indexed before.
+func (r *IndexerCheckpointRepository) Save(
+	ctx context.Context,
+	opts relayer.SaveIndexerCheckpointOpts,
+) error {
+	c := &relayer.IndexerCheckpoint{
+		ChainID:     opts.ChainID,
+		DestChainID: opts.DestChainID,
+		EventName:   opts.EventName,
+		BlockNumber: opts.BlockNumber,
+		BlockHash:   opts.BlockHash,
+	}
+
+	if err := r.db.GormDB().WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: "chain_id"}, {Name: "dest_chain_id"}, {Name: "event_name"}, {Name: "block_number"},
+		},
+		DoUpdates: clause.AssignmentColumns([]string{"block_hash"}),
+	}).Create(c).Error; err != nil {
+		return errors.Wrap(err, "r.db.Create")
+	}
+
+	return nil
+}
+
+// FindLatest returns the newest opts.Limit checkpoints of an indexer, newest first.
+func (r *IndexerCheckpointRepository) FindLatest(
+	ctx context.Context,
+	opts relayer.FindIndexerCheckpointsOpts,
+) ([]*relayer.IndexerCheckpoint, error) {
+	var checkpoints []*relayer.IndexerCheckpoint
+
+	if err := r.db.GormDB().WithContext(ctx).
+		Where("chain_id = ? AND dest_chain_id = ? AND event_name = ?", opts.ChainID, opts.DestChainID, opts.EventName).
+		Order("block_number DESC").
+		Limit(opts.Limit).
+		Find(&checkpoints).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return checkpoints, nil
+}
+
+// DeleteAfterBlockNumber deletes the checkpoints of an indexer after opts.BlockNumber,
+// which a reorg has replaced.
+func (r *IndexerCheckpointRepository) DeleteAfterBlockNumber(
+	ctx context.Context,
+	opts relayer.DeleteIndexerCheckpointsOpts,
+) error {
+	return r.delete(ctx, opts, "block_number > ?")
+}
+
+// DeleteBeforeBlockNumber deletes the checkpoints of an indexer before opts.BlockNumber,
+// which are too old to be checked for reorgs.
+func (r *IndexerCheckpointRepository) DeleteBeforeBlockNumber(
+	ctx context.Context,
+	opts relayer.DeleteIndexerCheckpointsOpts,
+) error {
+	return r.delete(ctx, opts, "block_number < ?")
+}
+
+func (r *IndexerCheckpointRepository) delete(
+	ctx context.Context,
+	opts relayer.DeleteIndexerCheckpointsOpts,
+	blockNumberCondition string,
+) error {
+	if err := r.db.GormDB().WithContext(ctx).
+		Where("chain_id = ? AND dest_chain_id = ? AND event_name = ?", opts.ChainID, opts.DestChainID, opts.EventName).
+		Where(blockNumberCondition, opts.BlockNumber).
+		Delete(&relayer.IndexerCheckpoint{}).Error; err != nil {
+		return errors.Wrap(err, "r.db.Delete")
+	}
+
+	return nil
+}
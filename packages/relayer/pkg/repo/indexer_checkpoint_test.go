@@ -0,0 +1,109 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"gopkg.in/go-playground/assert.v1"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+)
+
+func Test_NewIndexerCheckpointRepo(t *testing.T) {
+	tests := []struct {
+		name    string
+		db      db.DB
+		wantErr error
+	}{
+		{
+			"success",
+			&db.Database{},
+			nil,
+		},
+		{
+			"noDb",
+			nil,
+			db.ErrNoDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewIndexerCheckpointRepository(tt.db)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestIntegration_IndexerCheckpoint_SaveFindAndDelete(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, db db.DB) {
+		checkpointRepo, err := NewIndexerCheckpointRepository(db)
+		assert.Equal(t, nil, err)
+
+		for _, blockNumber := range []uint64{100, 200, 300} {
+			err = checkpointRepo.Save(context.Background(), relayer.SaveIndexerCheckpointOpts{
+				ChainID:     1,
+				DestChainID: 2,
+				EventName:   relayer.EventNameMessageSent,
+				BlockNumber: blockNumber,
+				BlockHash:   "0x1",
+			})
+			assert.Equal(t, nil, err)
+		}
+
+		// saving the same block again should replace its hash, not create a new checkpoint.
+		err = checkpointRepo.Save(context.Background(), relayer.SaveIndexerCheckpointOpts{
+			ChainID:     1,
+			DestChainID: 2,
+			EventName:   relayer.EventNameMessageSent,
+			BlockNumber: 300,
+			BlockHash:   "0x2",
+		})
+		assert.Equal(t, nil, err)
+
+		// another indexer's checkpoint should not be found.
+		err = checkpointRepo.Save(context.Background(), relayer.SaveIndexerCheckpointOpts{
+			ChainID:     1,
+			DestChainID: 2,
+			EventName:   relayer.EventNameMessageProcessed,
+			BlockNumber: 400,
+			BlockHash:   "0x1",
+		})
+		assert.Equal(t, nil, err)
+
+		findOpts := relayer.FindIndexerCheckpointsOpts{
+			ChainID:     1,
+			DestChainID: 2,
+			EventName:   relayer.EventNameMessageSent,
+			Limit:       2,
+		}
+
+		checkpoints, err := checkpointRepo.FindLatest(context.Background(), findOpts)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 2, len(checkpoints))
+		assert.Equal(t, uint64(300), checkpoints[0].BlockNumber)
+		assert.Equal(t, "0x2", checkpoints[0].BlockHash)
+		assert.Equal(t, uint64(200), checkpoints[1].BlockNumber)
+
+		deleteOpts := relayer.DeleteIndexerCheckpointsOpts{
+			ChainID:     1,
+			DestChainID: 2,
+			EventName:   relayer.EventNameMessageSent,
+			BlockNumber: 200,
+		}
+
+		err = checkpointRepo.DeleteAfterBlockNumber(context.Background(), deleteOpts)
+		assert.Equal(t, nil, err)
+
+		err = checkpointRepo.DeleteBeforeBlockNumber(context.Background(), deleteOpts)
+		assert.Equal(t, nil, err)
+
+		findOpts.Limit = 10
+
+		checkpoints, err = checkpointRepo.FindLatest(context.Background(), findOpts)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 1, len(checkpoints))
+		assert.Equal(t, uint64(200), checkpoints[0].BlockNumber)
+	})
+}
@@ -0,0 +1,74 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+)
+
+type SponsoredGasUsageRepository struct {
+	db db.DB
+}
+
+func NewSponsoredGasUsageRepository(dbHandler db.DB) (*SponsoredGasUsageRepository, error) {
+	if dbHandler == nil {
+		return nil, db.ErrNoDB
+	}
+
+	return &SponsoredGasUsageRepository{
+		db: dbHandler,
+	}, nil
+}
+
+// Add adds opts.GasUsed to the gas sponsored for the address on the day, creating
+// the row for it if it is the first message sponsored for the address that day.
+func (r *SponsoredGasUsageRepository) Add(
+	ctx context.Context,
+	opts relayer.AddSponsoredGasUsageOpts,
+) error {
+	u := &relayer.SponsoredGasUsage{
+		Policy:  opts.Policy,
+		Address: opts.Address,
+		Day:     opts.Day,
+		GasUsed: opts.GasUsed,
+	}
+
+	if err := r.db.GormDB().WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "policy"}, {Name: "address"}, {Name: "day"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"gas_used": gorm.Expr("sponsored_gas_usages.gas_used + ?", opts.GasUsed),
+		}),
+	}).Create(u).Error; err != nil {
+		return errors.Wrap(err, "r.db.Create")
+	}
+
+	return nil
+}
+
+// GasUsed returns the gas sponsored under a policy on a day, by opts.Address if set,
+// or by every address otherwise.
+func (r *SponsoredGasUsageRepository) GasUsed(
+	ctx context.Context,
+	opts relayer.FindSponsoredGasUsageOpts,
+) (uint64, error) {
+	q := r.db.GormDB().WithContext(ctx).
+		Model(&relayer.SponsoredGasUsage{}).
+		Where("policy = ? AND day = ?", opts.Policy, opts.Day)
+
+	if opts.Address != nil {
+		q = q.Where("address = ?", *opts.Address)
+	}
+
+	var gasUsed uint64
+
+	if err := q.Select("COALESCE(SUM(gas_used), 0)").Scan(&gasUsed).Error; err != nil {
+		return 0, errors.Wrap(err, "r.db.Scan")
+	}
+
+	return gasUsed, nil
+}
@@ -0,0 +1,79 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"gopkg.in/go-playground/assert.v1"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+)
+
+func Test_NewSponsoredGasUsageRepo(t *testing.T) {
+	tests := []struct {
+		name    string
+		db      db.DB
+		wantErr error
+	}{
+		{
+			"success",
+			&db.Database{},
+			nil,
+		},
+		{
+			"noDb",
+			nil,
+			db.ErrNoDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSponsoredGasUsageRepository(tt.db)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestIntegration_SponsoredGasUsage_AddAndGasUsed(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, db db.DB) {
+		usageRepo, err := NewSponsoredGasUsageRepository(db)
+		assert.Equal(t, nil, err)
+
+		for _, opts := range []relayer.AddSponsoredGasUsageOpts{
+			{Policy: "partner", Address: "0x1", Day: "2024-03-01", GasUsed: 100},
+			// adding to the same address and day should add to its gas used.
+			{Policy: "partner", Address: "0x1", Day: "2024-03-01", GasUsed: 50},
+			{Policy: "partner", Address: "0x2", Day: "2024-03-01", GasUsed: 25},
+			{Policy: "partner", Address: "0x1", Day: "2024-03-02", GasUsed: 1000},
+			{Policy: "other", Address: "0x1", Day: "2024-03-01", GasUsed: 1000},
+		} {
+			assert.Equal(t, nil, usageRepo.Add(context.Background(), opts))
+		}
+
+		address := "0x1"
+
+		gasUsed, err := usageRepo.GasUsed(context.Background(), relayer.FindSponsoredGasUsageOpts{
+			Policy:  "partner",
+			Address: &address,
+			Day:     "2024-03-01",
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, uint64(150), gasUsed)
+
+		gasUsed, err = usageRepo.GasUsed(context.Background(), relayer.FindSponsoredGasUsageOpts{
+			Policy: "partner",
+			Day:    "2024-03-01",
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, uint64(175), gasUsed)
+
+		gasUsed, err = usageRepo.GasUsed(context.Background(), relayer.FindSponsoredGasUsageOpts{
+			Policy: "partner",
+			Day:    "2024-03-03",
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, uint64(0), gasUsed)
+	})
+}
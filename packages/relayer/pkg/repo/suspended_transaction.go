@@ -0,0 +1,121 @@
+package repo
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/morkid/paginate"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+)
+
+type SuspendedTransactionRepository struct {
+	db db.DB
+}
+
+func NewSuspendedTransactionRepository(dbHandler db.DB) (*SuspendedTransactionRepository, error) {
+	if dbHandler == nil {
+		return nil, db.ErrNoDB
+	}
+
+	return &SuspendedTransactionRepository{
+		db: dbHandler,
+	}, nil
+}
+
+// Suspend marks the message with the given msgHash as suspended, creating a new
+// record if this message has never been suspended before. It is an upsert on the
+// unique msg_hash index, so concurrent suspensions of the same message do not race.
+func (r *SuspendedTransactionRepository) Suspend(
+	ctx context.Context,
+	opts relayer.SuspendTransactionOpts,
+) (*relayer.SuspendedTransaction, error) {
+	s := &relayer.SuspendedTransaction{
+		MessageID:    opts.MessageID,
+		SrcChainID:   opts.SrcChainID,
+		DestChainID:  opts.DestChainID,
+		MsgHash:      opts.MsgHash,
+		MessageOwner: opts.MessageOwner,
+		Suspended:    true,
+	}
+
+	if err := r.db.GormDB().WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "msg_hash"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"message_id", "src_chain_id", "dest_chain_id", "message_owner", "suspended", "updated_at",
+		}),
+	}).Create(s).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Create")
+	}
+
+	// the upserted record's ID is not reported back by every dialect when it is updated.
+	if err := r.db.GormDB().WithContext(ctx).Where("msg_hash = ?", opts.MsgHash).First(s).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.First")
+	}
+
+	return s, nil
+}
+
+// Unsuspend marks the message with the given msgHash as no longer suspended.
+func (r *SuspendedTransactionRepository) Unsuspend(ctx context.Context, msgHash string) error {
+	tx := r.db.GormDB().WithContext(ctx)
+	tx = tx.Model(&relayer.SuspendedTransaction{})
+	tx = tx.Where("msg_hash = ?", msgHash)
+
+	// check if existed.
+	var count int64
+	if err := tx.Count(&count).Error; err != nil {
+		return errors.Wrap(err, "r.db.Count")
+	}
+
+	if count == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	if err := tx.Update("suspended", false).Error; err != nil {
+		return errors.Wrap(err, "tx.Update")
+	}
+
+	return nil
+}
+
+// IsSuspended returns whether the message with the given msgHash is currently suspended.
+func (r *SuspendedTransactionRepository) IsSuspended(ctx context.Context, msgHash string) (bool, error) {
+	var count int64
+
+	if err := r.db.GormDB().WithContext(ctx).
+		Model(&relayer.SuspendedTransaction{}).
+		Where("msg_hash = ?", msgHash).
+		Where("suspended = ?", true).
+		Count(&count).Error; err != nil {
+		return false, errors.Wrap(err, "r.db.Count")
+	}
+
+	return count > 0, nil
+}
+
+func (r *SuspendedTransactionRepository) FindAllSuspended(
+	ctx context.Context,
+	req *http.Request,
+) (*paginate.Page, error) {
+	pg := paginate.New(&paginate.Config{
+		DefaultSize: 100,
+	})
+
+	q := r.db.GormDB().WithContext(ctx).
+		Model(&relayer.SuspendedTransaction{}).
+		Where("suspended = ?", true)
+
+	reqCtx := pg.With(q)
+
+	page := reqCtx.Request(req).Response(&[]relayer.SuspendedTransaction{})
+	if page.Error {
+		return nil, page.RawError
+	}
+
+	return &page, nil
+}
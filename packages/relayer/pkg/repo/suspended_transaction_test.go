@@ -0,0 +1,79 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"gopkg.in/go-playground/assert.v1"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+)
+
+func Test_NewSuspendedTransactionRepo(t *testing.T) {
+	tests := []struct {
+		name    string
+		db      db.DB
+		wantErr error
+	}{
+		{
+			"success",
+			&db.Database{},
+			nil,
+		},
+		{
+			"noDb",
+			nil,
+			db.ErrNoDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSuspendedTransactionRepository(tt.db)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestIntegration_SuspendedTransaction_SuspendAndUnsuspend(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, db db.DB) {
+		suspendedTxRepo, err := NewSuspendedTransactionRepository(db)
+		assert.Equal(t, nil, err)
+
+		opts := relayer.SuspendTransactionOpts{
+			MessageID:    1,
+			SrcChainID:   1,
+			DestChainID:  2,
+			MsgHash:      testMsgHash,
+			MessageOwner: addr.Hex(),
+		}
+
+		s, err := suspendedTxRepo.Suspend(context.Background(), opts)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, true, s.Suspended)
+
+		// suspending twice should update the existing record, not create a new one.
+		s2, err := suspendedTxRepo.Suspend(context.Background(), opts)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, s.ID, s2.ID)
+
+		suspended, err := suspendedTxRepo.IsSuspended(context.Background(), testMsgHash)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, true, suspended)
+
+		suspended, err = suspendedTxRepo.IsSuspended(context.Background(), testSecondMsgHash)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, false, suspended)
+
+		err = suspendedTxRepo.Unsuspend(context.Background(), testMsgHash)
+		assert.Equal(t, nil, err)
+
+		suspended, err = suspendedTxRepo.IsSuspended(context.Background(), testMsgHash)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, false, suspended)
+
+		err = suspendedTxRepo.Unsuspend(context.Background(), testSecondMsgHash)
+		assert.NotEqual(t, nil, err)
+	})
+}
@@ -0,0 +1,290 @@
+package processor
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
+)
+
+var (
+	ErrNoBatchProcessorAddress = errors.New("destBatchProcessorAddress is required when batching is enabled")
+
+	// errBatchedCallFailed is returned for a call of a batched transaction which did
+	// not process its message, while the others did.
+	errBatchedCallFailed = errors.New("batched processMessage call failed")
+
+	// batchCallGasOverhead is the gas a batch processor spends on each call, on top of
+	// the call itself.
+	batchCallGasOverhead uint64 = 10_000
+)
+
+// processMessageCall is a `bridge.processMessage` call which has been checked and
+// priced, ready to be sent.
+type processMessageCall struct {
+	event    *bridge.BridgeMessageSent
+	data     []byte
+	gasLimit uint64
+	// proofBlockNumber is the block the call's proof is generated against. Only calls
+	// proven against the same block are batched together.
+	proofBlockNumber uint64
+}
+
+// processMessageResult is the outcome of a processMessageCall. gasUsed is the call's
+// share of the gas used by the transaction it was sent in. The gas of a batch is only
+// shared by the calls which processed their message, in proportion to their gas limits
+// plus batchCallGasOverhead, so they also bear the gas of the calls which failed, which
+// the relayer spent to process them all the same.
+type processMessageResult struct {
+	receipt *types.Receipt
+	gasUsed uint64
+	err     error
+}
+
+// pendingBatch is the calls buffered for a proof block, and where to send their results.
+type pendingBatch struct {
+	calls   []*processMessageCall
+	results []chan processMessageResult
+}
+
+// batcher buffers processMessageCalls for a window, so the calls proven against the
+// same block can be sent in one transaction. A batch is sent once its window is up,
+// or once it holds maxSize calls, whichever is first, with ctx, so that a batch is not
+// canceled by the context of the call which happened to start it.
+type batcher struct {
+	ctx     context.Context
+	window  time.Duration
+	maxSize int
+	send    func(ctx context.Context, calls []*processMessageCall) []processMessageResult
+
+	mu      sync.Mutex
+	batches map[uint64]*pendingBatch
+}
+
+func newBatcher(
+	ctx context.Context,
+	window time.Duration,
+	maxSize uint64,
+	send func(ctx context.Context, calls []*processMessageCall) []processMessageResult,
+) *batcher {
+	return &batcher{
+		ctx:     ctx,
+		window:  window,
+		maxSize: int(maxSize),
+		send:    send,
+		batches: make(map[uint64]*pendingBatch),
+	}
+}
+
+// add buffers call, and blocks until the batch it is added to has been sent and its
+// result is known, so each message is still acknowledged on its own.
+func (b *batcher) add(ctx context.Context, call *processMessageCall) processMessageResult {
+	result := make(chan processMessageResult, 1)
+
+	b.mu.Lock()
+
+	batch, ok := b.batches[call.proofBlockNumber]
+	if !ok {
+		batch = &pendingBatch{}
+		b.batches[call.proofBlockNumber] = batch
+
+		time.AfterFunc(b.window, func() {
+			b.flush(call.proofBlockNumber, batch)
+		})
+	}
+
+	batch.calls = append(batch.calls, call)
+	batch.results = append(batch.results, result)
+
+	full := len(batch.calls) >= b.maxSize
+
+	b.mu.Unlock()
+
+	if full {
+		go b.flush(call.proofBlockNumber, batch)
+	}
+
+	select {
+	case r := <-result:
+		return r
+	case <-ctx.Done():
+		return processMessageResult{err: ctx.Err()}
+	}
+}
+
+// flush sends batch, unless it has been sent already.
+func (b *batcher) flush(proofBlockNumber uint64, batch *pendingBatch) {
+	b.mu.Lock()
+
+	if b.batches[proofBlockNumber] != batch {
+		b.mu.Unlock()
+		return
+	}
+
+	delete(b.batches, proofBlockNumber)
+
+	b.mu.Unlock()
+
+	results := b.send(b.ctx, batch.calls)
+
+	for i, r := range results {
+		batch.results[i] <- r
+	}
+}
+
+// sendProcessMessageCalls sends calls in one transaction, straight to the bridge if
+// there is only one, or otherwise through the BridgeBatchProcessor, which forwards the
+// processing fees the bridge pays it to the relayer, and returns the result of each.
+// A batched call is attributed the receipt of the transaction if it emitted a
+// MessageStatusChanged event changing the status of the call's message to DONE.
+func (p *Processor) sendProcessMessageCalls(
+	ctx context.Context,
+	calls []*processMessageCall,
+) []processMessageResult {
+	results := make([]processMessageResult, len(calls))
+
+	setErr := func(err error) []processMessageResult {
+		for i := range results {
+			results[i].err = err
+		}
+
+		return results
+	}
+
+	srcTxHashes := make([]string, 0, len(calls))
+	for _, call := range calls {
+		srcTxHashes = append(srcTxHashes, call.event.Raw.TxHash.Hex())
+	}
+
+	candidate := txmgr.TxCandidate{
+		TxData:   calls[0].data,
+		Blobs:    nil,
+		To:       &p.cfg.DestBridgeAddress,
+		GasLimit: calls[0].gasLimit,
+	}
+
+	if len(calls) > 1 {
+		call3s := make([]encoding.Call3, 0, len(calls))
+
+		var gasLimit uint64
+
+		for _, call := range calls {
+			call3s = append(call3s, encoding.Call3{
+				Target:       p.cfg.DestBridgeAddress,
+				AllowFailure: true,
+				CallData:     call.data,
+			})
+
+			gasLimit += call.gasLimit + batchCallGasOverhead
+		}
+
+		data, err := encoding.MulticallABI.Pack("aggregate3", call3s)
+		if err != nil {
+			return setErr(err)
+		}
+
+		candidate = txmgr.TxCandidate{
+			TxData:   data,
+			Blobs:    nil,
+			To:       &p.cfg.DestBatchProcessorAddress,
+			GasLimit: gasLimit,
+		}
+
+		relayer.ProcessMessageBatchesSent.Inc()
+	}
+
+	receipt, err := p.txmgr.Send(ctx, candidate)
+	if err != nil {
+		slog.Warn("Failed to send ProcessMessage transaction", "error", err.Error())
+		return setErr(err)
+	}
+
+	slog.Info("Mined tx",
+		"txHash", hex.EncodeToString(receipt.TxHash.Bytes()),
+		"srcTxHashes", srcTxHashes,
+	)
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		relayer.MessageSentEventsProcessedReverted.Add(float64(len(calls)))
+		slog.Warn("Transaction reverted", "txHash", hex.EncodeToString(receipt.TxHash.Bytes()),
+			"srcTxHashes", srcTxHashes,
+			"status", receipt.Status)
+
+		return setErr(errTxReverted)
+	}
+
+	if len(calls) == 1 {
+		results[0] = processMessageResult{receipt: receipt, gasUsed: receipt.GasUsed}
+
+		return results
+	}
+
+	processed := make([]bool, len(calls))
+
+	var totalGasLimit uint64
+
+	for i, call := range calls {
+		for _, log := range receipt.Logs {
+			if log.Address == p.cfg.DestBridgeAddress && isMessageDoneLog(log, call.event.MsgHash) {
+				processed[i] = true
+				totalGasLimit += call.gasLimit + batchCallGasOverhead
+
+				break
+			}
+		}
+	}
+
+	for i, call := range calls {
+		if !processed[i] {
+			relayer.MessageSentEventsProcessedReverted.Inc()
+			slog.Warn("Batched call failed", "txHash", hex.EncodeToString(receipt.TxHash.Bytes()),
+				"srcTxHash", call.event.Raw.TxHash.Hex(),
+			)
+
+			results[i].err = errBatchedCallFailed
+
+			continue
+		}
+
+		results[i] = processMessageResult{
+			receipt: receipt,
+			gasUsed: receipt.GasUsed * (call.gasLimit + batchCallGasOverhead) / totalGasLimit,
+		}
+	}
+
+	return results
+}
+
+// isMessageStatusChangedLog returns whether log is a MessageStatusChanged event of the
+// message with msgHash.
+func isMessageStatusChangedLog(log *types.Log, eventID common.Hash, msgHash [32]byte) bool {
+	return len(log.Topics) > 1 && log.Topics[0] == eventID && log.Topics[1] == common.Hash(msgHash)
+}
+
+// isMessageDoneLog returns whether log is a MessageStatusChanged event changing the
+// status of the message with msgHash to DONE. A message whose invocation failed has its
+// status changed to RETRIABLE instead, and has not been processed.
+func isMessageDoneLog(log *types.Log, msgHash [32]byte) bool {
+	if !isMessageStatusChangedLog(log, encoding.BridgeABI.Events["MessageStatusChanged"].ID, msgHash) {
+		return false
+	}
+
+	m := make(map[string]interface{})
+	if err := encoding.BridgeABI.UnpackIntoMap(m, "MessageStatusChanged", log.Data); err != nil {
+		return false
+	}
+
+	status, ok := m["status"].(uint8)
+
+	return ok && relayer.EventStatus(status) == relayer.EventStatusDone
+}
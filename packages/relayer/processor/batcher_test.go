@@ -0,0 +1,198 @@
+package processor
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+// receiptTxManager returns receipt for every transaction sent, and records the candidates.
+type receiptTxManager struct {
+	mock.TxManager
+	receipt    *types.Receipt
+	candidates []txmgr.TxCandidate
+}
+
+func (t *receiptTxManager) Send(ctx context.Context, candidate txmgr.TxCandidate) (*types.Receipt, error) {
+	t.candidates = append(t.candidates, candidate)
+
+	return t.receipt, nil
+}
+
+func testProcessMessageCall(msgHash common.Hash, proofBlockNumber uint64) *processMessageCall {
+	return &processMessageCall{
+		event: &bridge.BridgeMessageSent{
+			MsgHash: msgHash,
+		},
+		data:             msgHash.Bytes(),
+		gasLimit:         100_000,
+		proofBlockNumber: proofBlockNumber,
+	}
+}
+
+func Test_batcher_groupsCallsByProofBlock(t *testing.T) {
+	var mu sync.Mutex
+
+	batchSizes := make(map[uint64]int)
+
+	b := newBatcher(context.Background(), 50*time.Millisecond, 20,
+		func(ctx context.Context, calls []*processMessageCall) []processMessageResult {
+			mu.Lock()
+			defer mu.Unlock()
+
+			batchSizes[calls[0].proofBlockNumber] = len(calls)
+
+			return make([]processMessageResult, len(calls))
+		})
+
+	var wg sync.WaitGroup
+
+	for _, proofBlockNumber := range []uint64{1, 1, 2} {
+		wg.Add(1)
+
+		go func(proofBlockNumber uint64) {
+			defer wg.Done()
+
+			result := b.add(context.Background(), testProcessMessageCall(common.BigToHash(common.Big1), proofBlockNumber))
+			assert.Nil(t, result.err)
+		}(proofBlockNumber)
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, map[uint64]int{1: 2, 2: 1}, batchSizes)
+}
+
+func Test_batcher_sendsFullBatchBeforeWindow(t *testing.T) {
+	b := newBatcher(context.Background(), time.Hour, 2,
+		func(ctx context.Context, calls []*processMessageCall) []processMessageResult {
+			return make([]processMessageResult, len(calls))
+		})
+
+	done := make(chan struct{})
+
+	go func() {
+		_ = b.add(context.Background(), testProcessMessageCall(common.HexToHash("0x1"), 1))
+		close(done)
+	}()
+
+	_ = b.add(context.Background(), testProcessMessageCall(common.HexToHash("0x2"), 1))
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("full batch was not sent")
+	}
+}
+
+func Test_batcher_sendsWithItsOwnContext(t *testing.T) {
+	sent := make(chan error, 1)
+
+	b := newBatcher(context.Background(), 50*time.Millisecond, 20,
+		func(ctx context.Context, calls []*processMessageCall) []processMessageResult {
+			sent <- ctx.Err()
+
+			return make([]processMessageResult, len(calls))
+		})
+
+	// the call which starts the batch gives up before the batch is sent.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result := b.add(ctx, testProcessMessageCall(common.HexToHash("0x1"), 1))
+	assert.Equal(t, context.Canceled, result.err)
+
+	result = b.add(context.Background(), testProcessMessageCall(common.HexToHash("0x2"), 1))
+	assert.Nil(t, result.err)
+	assert.Nil(t, <-sent)
+}
+
+func Test_sendProcessMessageCalls_batch(t *testing.T) {
+	p := newTestProcessor(false)
+	p.cfg.DestBatchProcessorAddress = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+	processed := common.HexToHash("0x1")
+	failed := common.HexToHash("0x2")
+	otherProcessed := common.HexToHash("0x3")
+	retriable := common.HexToHash("0x4")
+
+	statusChangedID := encoding.BridgeABI.Events["MessageStatusChanged"].ID
+	done := common.LeftPadBytes([]byte{byte(relayer.EventStatusDone)}, 32)
+
+	receipt := &types.Receipt{
+		Status:  types.ReceiptStatusSuccessful,
+		GasUsed: 100_000,
+		Logs: []*types.Log{
+			{
+				Address: p.cfg.DestBridgeAddress,
+				Topics:  []common.Hash{statusChangedID, processed},
+				Data:    done,
+			},
+			{
+				Address: p.cfg.DestBridgeAddress,
+				Topics:  []common.Hash{statusChangedID, otherProcessed},
+				Data:    done,
+			},
+			{
+				Address: p.cfg.DestBridgeAddress,
+				Topics:  []common.Hash{statusChangedID, retriable},
+				Data:    common.LeftPadBytes([]byte{byte(relayer.EventStatusRetriable)}, 32),
+			},
+		},
+	}
+
+	txManager := &receiptTxManager{receipt: receipt}
+	p.txmgr = txManager
+
+	results := p.sendProcessMessageCalls(context.Background(), []*processMessageCall{
+		testProcessMessageCall(processed, 1),
+		testProcessMessageCall(failed, 1),
+		testProcessMessageCall(otherProcessed, 1),
+		testProcessMessageCall(retriable, 1),
+	})
+
+	assert.Equal(t, 1, len(txManager.candidates))
+	assert.Equal(t, p.cfg.DestBatchProcessorAddress, *txManager.candidates[0].To)
+	assert.Equal(t, 4*(100_000+batchCallGasOverhead), txManager.candidates[0].GasLimit)
+
+	// the gas of the transaction is shared by the calls which processed their message.
+	assert.Nil(t, results[0].err)
+	assert.Equal(t, receipt, results[0].receipt)
+	assert.Equal(t, uint64(50_000), results[0].gasUsed)
+
+	assert.Equal(t, errBatchedCallFailed, results[1].err)
+
+	assert.Nil(t, results[2].err)
+	assert.Equal(t, uint64(50_000), results[2].gasUsed)
+
+	// a message whose invocation failed has become retriable, rather than processed.
+	assert.Equal(t, errBatchedCallFailed, results[3].err)
+}
+
+func Test_sendProcessMessageCalls_single(t *testing.T) {
+	p := newTestProcessor(false)
+
+	receipt := &types.Receipt{Status: types.ReceiptStatusSuccessful, GasUsed: 100_000}
+
+	txManager := &receiptTxManager{receipt: receipt}
+	p.txmgr = txManager
+
+	results := p.sendProcessMessageCalls(context.Background(), []*processMessageCall{
+		testProcessMessageCall(common.HexToHash("0x1"), 1),
+	})
+
+	assert.Equal(t, p.cfg.DestBridgeAddress, *txManager.candidates[0].To)
+	assert.Nil(t, results[0].err)
+	assert.Equal(t, uint64(100_000), results[0].gasUsed)
+}
@@ -3,12 +3,13 @@ package processor
 import (
 	"crypto/ecdsa"
 	"fmt"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/urfave/cli/v2"
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
@@ -16,7 +17,7 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
 	pkgFlags "github.com/taikoxyz/taiko-mono/packages/relayer/pkg/flags"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
-	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/rabbitmq"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/backend"
 )
 
 // hopConfig is a config struct that must be provided for an individual
@@ -41,6 +42,12 @@ type Config struct {
 	DestERC1155VaultAddress common.Address
 	DestTaikoAddress        common.Address
 	DestQuotaManagerAddress common.Address
+	// DestSignalServiceAddress is optional, and only used to look up the roots the
+	// destination chain has cached.
+	DestSignalServiceAddress common.Address
+	// L1FeeOracleAddress is an optional GasPriceOracle on the destination chain,
+	// charging an L1 data fee on top of the execution cost of a transaction.
+	L1FeeOracleAddress common.Address
 
 	// private key
 	ProcessorPrivateKey *ecdsa.PrivateKey
@@ -52,13 +59,17 @@ type Config struct {
 	Confirmations        uint64
 	ConfirmationsTimeout uint64
 	ProfitableOnly       bool
-	EnableTaikoL2        bool
+	// ProfitMargin is the percentage the processing fee must exceed the estimated
+	// cost of processing a message by for it to be profitable.
+	ProfitMargin  uint64
+	EnableTaikoL2 bool
 
 	// backoff configs
 	BackoffRetryInterval uint64
 	BackOffMaxRetrys     uint64
 
 	// db configs
+	DatabaseDialect         string
 	DatabaseUsername        string
 	DatabasePassword        string
 	DatabaseName            string
@@ -67,17 +78,20 @@ type Config struct {
 	DatabaseMaxOpenConns    uint64
 	DatabaseMaxConnLifetime uint64
 	// queue configs
+	QueueType     string
+	QueueDataDir  string
 	QueueUsername string
 	QueuePassword string
 	QueueHost     string
 	QueuePort     uint64
 	QueuePrefetch uint64
 	// rpc configs
-	SrcRPCUrl        string
-	DestRPCUrl       string
-	ETHClientTimeout uint64
-	OpenQueueFunc    func() (queue.Queue, error)
-	OpenDBFunc       func() (db.DB, error)
+	SrcRPCUrl         string
+	DestRPCUrl        string
+	ETHClientTimeout  uint64
+	OpenQueueFunc     func() (queue.Queue, error)
+	OpenDBFunc        func() (db.DB, error)
+	DialRPCClientFunc func(url string) (*rpc.Client, error)
 
 	hopConfigs []hopConfig
 
@@ -85,9 +99,27 @@ type Config struct {
 	UnprofitableMessageQueueExpiration *string
 
 	TxmgrConfigs *txmgr.CLIConfig
+	// TxManagerFunc, if set, returns the TxManager to send with instead of one being
+	// created from TxmgrConfigs, so processors sending from the same key to the same
+	// chain can share one, and with it its nonce management.
+	TxManagerFunc func() (txmgr.TxManager, error)
+	// ProfitabilityEvaluator, if set, replaces the DefaultProfitabilityEvaluator
+	// when ProfitableOnly is set.
+	ProfitabilityEvaluator ProfitabilityEvaluator
 
 	MaxMessageRetries uint64
 	MinFeeToProcess   uint64
+
+	// batching configs
+	// BatchWindow is how long processMessage calls proven against the same block are
+	// buffered to be sent in one transaction, through DestBatchProcessorAddress.
+	// Batching is disabled when it is 0.
+	BatchWindow               time.Duration
+	BatchMaxSize              uint64
+	DestBatchProcessorAddress common.Address
+
+	// SponsorshipPolicies select messages to process regardless of their fee.
+	SponsorshipPolicies []SponsorshipPolicy
 }
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
@@ -118,6 +150,10 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		})
 	}
 
+	if c.Uint64(flags.BatchMaxSize.Name) < 1 {
+		return nil, fmt.Errorf("batch.maxSize must be at least 1")
+	}
+
 	var targetTxHash *common.Hash
 
 	if c.IsSet(flags.TargetTxHash.Name) {
@@ -137,6 +173,28 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		destQuotaManagerAddress = common.HexToAddress(c.String(flags.DestQuotaManagerAddress.Name))
 	}
 
+	var destSignalServiceAddress common.Address
+	if c.IsSet(flags.DestSignalServiceAddress.Name) {
+		destSignalServiceAddress = common.HexToAddress(c.String(flags.DestSignalServiceAddress.Name))
+	}
+
+	var destBatchProcessorAddress common.Address
+	if c.IsSet(flags.DestBatchProcessorAddress.Name) {
+		destBatchProcessorAddress = common.HexToAddress(c.String(flags.DestBatchProcessorAddress.Name))
+	}
+
+	var sponsorshipPolicies []SponsorshipPolicy
+	if c.IsSet(flags.SponsorshipPoliciesFile.Name) {
+		if sponsorshipPolicies, err = LoadSponsorshipPolicies(c.String(flags.SponsorshipPoliciesFile.Name)); err != nil {
+			return nil, err
+		}
+	}
+
+	var l1FeeOracleAddress common.Address
+	if c.IsSet(flags.L1FeeOracleAddress.Name) {
+		l1FeeOracleAddress = common.HexToAddress(c.String(flags.L1FeeOracleAddress.Name))
+	}
+
 	return &Config{
 		hopConfigs:                         hopConfigs,
 		ProcessorPrivateKey:                processorPrivateKey,
@@ -147,6 +205,10 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		DestERC20VaultAddress:              common.HexToAddress(c.String(flags.DestERC20VaultAddress.Name)),
 		DestERC1155VaultAddress:            common.HexToAddress(c.String(flags.DestERC1155VaultAddress.Name)),
 		DestQuotaManagerAddress:            destQuotaManagerAddress,
+		DestSignalServiceAddress:           destSignalServiceAddress,
+		L1FeeOracleAddress:                 l1FeeOracleAddress,
+		DestBatchProcessorAddress:          destBatchProcessorAddress,
+		DatabaseDialect:                    c.String(flags.DatabaseDialect.Name),
 		DatabaseUsername:                   c.String(flags.DatabaseUsername.Name),
 		DatabasePassword:                   c.String(flags.DatabasePassword.Name),
 		DatabaseName:                       c.String(flags.DatabaseName.Name),
@@ -154,6 +216,8 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		DatabaseMaxIdleConns:               c.Uint64(flags.DatabaseMaxIdleConns.Name),
 		DatabaseMaxOpenConns:               c.Uint64(flags.DatabaseMaxOpenConns.Name),
 		DatabaseMaxConnLifetime:            c.Uint64(flags.DatabaseConnMaxLifetime.Name),
+		QueueType:                          c.String(flags.QueueType.Name),
+		QueueDataDir:                       c.String(flags.QueueDataDir.Name),
 		QueueUsername:                      c.String(flags.QueueUsername.Name),
 		QueuePassword:                      c.String(flags.QueuePassword.Name),
 		QueuePort:                          c.Uint64(flags.QueuePort.Name),
@@ -166,6 +230,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		ConfirmationsTimeout:               c.Uint64(flags.ConfirmationTimeout.Name),
 		EnableTaikoL2:                      c.Bool(flags.EnableTaikoL2.Name),
 		ProfitableOnly:                     c.Bool(flags.ProfitableOnly.Name),
+		ProfitMargin:                       c.Uint64(flags.ProfitMargin.Name),
 		BackoffRetryInterval:               c.Uint64(flags.BackOffRetryInterval.Name),
 		BackOffMaxRetrys:                   c.Uint64(flags.BackOffMaxRetrys.Name),
 		ETHClientTimeout:                   c.Uint64(flags.ETHClientTimeout.Name),
@@ -177,10 +242,15 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 			processorPrivateKey,
 			c,
 		),
-		MaxMessageRetries: c.Uint64(flags.MaxMessageRetries.Name),
-		MinFeeToProcess:   c.Uint64(flags.MinFeeToProcess.Name),
+		MaxMessageRetries:   c.Uint64(flags.MaxMessageRetries.Name),
+		MinFeeToProcess:     c.Uint64(flags.MinFeeToProcess.Name),
+		BatchWindow:         c.Duration(flags.BatchWindow.Name),
+		BatchMaxSize:        c.Uint64(flags.BatchMaxSize.Name),
+		SponsorshipPolicies: sponsorshipPolicies,
+		DialRPCClientFunc:   rpc.Dial,
 		OpenDBFunc: func() (db.DB, error) {
 			return db.OpenDBConnection(db.DBConnectionOpts{
+				Dialect:         db.Dialect(c.String(flags.DatabaseDialect.Name)),
 				Name:            c.String(flags.DatabaseUsername.Name),
 				Password:        c.String(flags.DatabasePassword.Name),
 				Database:        c.String(flags.DatabaseName.Name),
@@ -188,8 +258,8 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 				MaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
 				MaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
 				MaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
-				OpenFunc: func(dsn string) (db.DB, error) {
-					gormDB, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+				OpenFunc: func(dialector gorm.Dialector) (db.DB, error) {
+					gormDB, err := gorm.Open(dialector, &gorm.Config{
 						Logger: logger.Default.LogMode(logger.Silent),
 					})
 					if err != nil {
@@ -207,9 +277,10 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 				Host:          c.String(flags.QueueHost.Name),
 				Port:          c.String(flags.QueuePort.Name),
 				PrefetchCount: c.Uint64(flags.QueuePrefetchCount.Name),
+				DataDir:       c.String(flags.QueueDataDir.Name),
 			}
 
-			q, err := rabbitmq.NewQueue(opts)
+			q, err := backend.Open(queue.Type(c.String(flags.QueueType.Name)), opts)
 			if err != nil {
 				return nil, err
 			}
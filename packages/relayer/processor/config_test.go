@@ -2,6 +2,7 @@ package processor
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
 )
@@ -16,6 +18,7 @@ import (
 var (
 	destBridgeAddr          = "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD377"
 	destQuotaManagerAddr    = "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD357"
+	destSignalServiceAddr   = "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD367"
 	headerSyncInterval      = "30"
 	confirmations           = "10"
 	confirmationTimeout     = "30"
@@ -48,6 +51,8 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		assert.Equal(t, "dbpass", c.DatabasePassword)
 		assert.Equal(t, "dbname", c.DatabaseName)
 		assert.Equal(t, "dbhost", c.DatabaseHost)
+		assert.Equal(t, "inprocess", c.QueueType)
+		assert.Equal(t, "queuedatadir", c.QueueDataDir)
 		assert.Equal(t, "queuename", c.QueueUsername)
 		assert.Equal(t, "queuepassword", c.QueuePassword)
 		assert.Equal(t, "queuehost", c.QueueHost)
@@ -70,8 +75,17 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		assert.Equal(t, uint64(30), c.DatabaseMaxConnLifetime)
 		assert.Equal(t, uint64(10), c.ETHClientTimeout)
 		assert.Equal(t, true, c.ProfitableOnly)
+		assert.Equal(t, uint64(15), c.ProfitMargin)
+		assert.Equal(t, common.HexToAddress(destBridgeAddr), c.L1FeeOracleAddress)
 		assert.Equal(t, uint64(100), c.QueuePrefetch)
 		assert.Equal(t, true, c.EnableTaikoL2)
+		assert.Equal(t, common.HexToAddress(destSignalServiceAddr), c.DestSignalServiceAddress)
+		// roots are not cached unless the cacheOption flag is set.
+		assert.Equal(t, int(encoding.CACHE_NOTHING), c.CacheOption)
+		assert.Equal(t, 2*time.Second, c.BatchWindow)
+		assert.Equal(t, uint64(10), c.BatchMaxSize)
+		assert.Equal(t, common.HexToAddress(destBridgeAddr), c.DestBatchProcessorAddress)
+		assert.Equal(t, 2, len(c.SponsorshipPolicies))
 
 		c.OpenDBFunc = func() (db.DB, error) {
 			return &mock.DB{}, nil
@@ -92,6 +106,8 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		"--" + flags.DatabasePassword.Name, "dbpass",
 		"--" + flags.DatabaseHost.Name, "dbhost",
 		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.QueueType.Name, "inprocess",
+		"--" + flags.QueueDataDir.Name, "queuedatadir",
 		"--" + flags.QueueUsername.Name, "queuename",
 		"--" + flags.QueuePassword.Name, "queuepassword",
 		"--" + flags.QueueHost.Name, "queuehost",
@@ -116,8 +132,15 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		"--" + flags.ETHClientTimeout.Name, ethClientTimeout,
 		"--" + flags.QueuePrefetchCount.Name, "100",
 		"--" + flags.ProfitableOnly.Name,
+		"--" + flags.ProfitMargin.Name, "15",
+		"--" + flags.L1FeeOracleAddress.Name, destBridgeAddr,
 		"--" + flags.EnableTaikoL2.Name,
 		"--" + flags.DestQuotaManagerAddress.Name, destQuotaManagerAddr,
+		"--" + flags.DestSignalServiceAddress.Name, destSignalServiceAddr,
+		"--" + flags.BatchWindow.Name, "2s",
+		"--" + flags.BatchMaxSize.Name, "10",
+		"--" + flags.DestBatchProcessorAddress.Name, destBridgeAddr,
+		"--" + flags.SponsorshipPoliciesFile.Name, writeRoutesFile(t, testSponsorshipPolicies),
 	}))
 }
 
@@ -145,3 +168,31 @@ func TestNewConfigFromCliContext_PrivKeyError(t *testing.T) {
 		"--" + flags.DestQuotaManagerAddress.Name, destQuotaManagerAddr,
 	}), "invalid processorPrivateKey")
 }
+
+func TestNewConfigFromCliContext_BatchMaxSizeError(t *testing.T) {
+	app := setupApp()
+	assert.ErrorContains(t, app.Run([]string{
+		"TestingNewConfigFromCliContext",
+		"--" + flags.DatabaseUsername.Name, "dbuser",
+		"--" + flags.DatabasePassword.Name, "dbpass",
+		"--" + flags.DatabaseHost.Name, "dbhost",
+		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.QueueUsername.Name, "queuename",
+		"--" + flags.QueuePassword.Name, "queuepassword",
+		"--" + flags.QueueHost.Name, "queuehost",
+		"--" + flags.QueuePort.Name, "5555",
+		"--" + flags.SrcRPCUrl.Name, "srcRpcUrl",
+		"--" + flags.DestRPCUrl.Name, "destRpcUrl",
+		"--" + flags.DestBridgeAddress.Name, destBridgeAddr,
+		"--" + flags.SrcSignalServiceAddress.Name, destBridgeAddr,
+		"--" + flags.DestERC721VaultAddress.Name, destBridgeAddr,
+		"--" + flags.DestERC20VaultAddress.Name, destBridgeAddr,
+		"--" + flags.DestERC1155VaultAddress.Name, destBridgeAddr,
+		"--" + flags.DestTaikoAddress.Name, destBridgeAddr,
+		"--" + flags.ProcessorPrivateKey.Name, dummyEcdsaKey,
+		"--" + flags.DestQuotaManagerAddress.Name, destQuotaManagerAddr,
+		"--" + flags.BatchWindow.Name, "2s",
+		"--" + flags.BatchMaxSize.Name, "0",
+		"--" + flags.DestBatchProcessorAddress.Name, destBridgeAddr,
+	}), "batch.maxSize must be at least 1")
+}
@@ -0,0 +1,117 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+// defaultRetryDelay is how long, in milliseconds, a message waits in the delayed
+// queue before it is retried, when no unprofitableMessageQueueExpiration is configured.
+var defaultRetryDelay = "60000"
+
+// unprofitableQueueExpiration returns how long a message published to the delayed queue
+// waits before it expires back onto the main queue, falling back to defaultRetryDelay.
+func (p *Processor) unprofitableQueueExpiration() *string {
+	if p.cfg.UnprofitableMessageQueueExpiration == nil {
+		return &defaultRetryDelay
+	}
+
+	return p.cfg.UnprofitableMessageQueueExpiration
+}
+
+// retryMessageBody returns the queue message body with lastErr recorded, and its retry
+// count incremented if the failure counts towards maxMessageRetries, ready to be
+// published again.
+func retryMessageBody(body []byte, lastErr error, countAttempt bool) ([]byte, error) {
+	msgBody := &queue.QueueMessageSentBody{}
+	if err := json.Unmarshal(body, msgBody); err != nil {
+		return nil, errors.Wrap(err, "json.Unmarshal")
+	}
+
+	if countAttempt {
+		msgBody.TimesRetried++
+	}
+
+	msgBody.LastError = lastErr.Error()
+
+	marshalled, err := json.Marshal(msgBody)
+	if err != nil {
+		return nil, errors.Wrap(err, "json.Marshal")
+	}
+
+	return marshalled, nil
+}
+
+// retryMessage publishes the message to the delayed queue, which routes it back onto the
+// main queue once it expires, so a failing message is backed off instead of being retried
+// straight away. Only actual processing attempts count towards maxMessageRetries, transient
+// errors before the message could be processed do not.
+func (p *Processor) retryMessage(ctx context.Context, msg queue.Message, lastErr error) error {
+	countAttempt := errors.Is(lastErr, errProcessingAttemptFailed)
+
+	body, err := retryMessageBody(msg.Body, lastErr, countAttempt)
+	if err != nil {
+		return err
+	}
+
+	if err := p.queue.Publish(
+		ctx,
+		fmt.Sprintf("%v-unprofitable", p.queueName()),
+		body,
+		nil,
+		p.unprofitableQueueExpiration(),
+	); err != nil {
+		return errors.Wrap(err, "p.queue.Publish")
+	}
+
+	if countAttempt {
+		relayer.MessageSentEventsRetries.Inc()
+	}
+
+	return nil
+}
+
+// deadLetterMessage records a message which reached maxMessageRetries, along with the
+// last error it failed with. The record is what `relayer replay` uses to put the message
+// back onto the queue, so the message is not published anywhere else.
+func (p *Processor) deadLetterMessage(ctx context.Context, msg queue.Message) error {
+	msgBody := &queue.QueueMessageSentBody{}
+	if err := json.Unmarshal(msg.Body, msgBody); err != nil {
+		return errors.Wrap(err, "json.Unmarshal")
+	}
+
+	if msgBody.Event == nil {
+		return errors.New("empty message body")
+	}
+
+	msgHash := common.Hash(msgBody.Event.MsgHash).Hex()
+
+	if _, err := p.deadLetterRepo.Save(ctx, relayer.SaveDeadLetterMessageOpts{
+		MessageID:    msgBody.ID,
+		SrcChainID:   p.srcChainId.Int64(),
+		DestChainID:  p.destChainId.Int64(),
+		MsgHash:      msgHash,
+		TimesRetried: msgBody.TimesRetried,
+		LastError:    msgBody.LastError,
+		Body:         msg.Body,
+	}); err != nil {
+		return errors.Wrap(err, "p.deadLetterRepo.Save")
+	}
+
+	slog.Warn("message dead-lettered",
+		"msgHash", msgHash,
+		"timesRetried", msgBody.TimesRetried,
+		"lastError", msgBody.LastError,
+	)
+
+	relayer.MessageSentEventsMaxRetriesReached.Inc()
+
+	return nil
+}
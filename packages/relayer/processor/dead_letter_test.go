@@ -0,0 +1,134 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+func Test_retryMessageBody(t *testing.T) {
+	tests := []struct {
+		name             string
+		lastErr          error
+		wantTimesRetried uint64
+	}{
+		{
+			"processingAttemptFailed",
+			fmt.Errorf("%w: %w", errProcessingAttemptFailed, errors.New("execution reverted")),
+			3,
+		},
+		{
+			"transientError",
+			errors.New("execution reverted"),
+			2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			marshalled, err := json.Marshal(queue.QueueMessageSentBody{
+				ID:           1,
+				TimesRetried: 2,
+			})
+			assert.Nil(t, err)
+
+			retryBody, err := retryMessageBody(
+				marshalled,
+				tt.lastErr,
+				errors.Is(tt.lastErr, errProcessingAttemptFailed),
+			)
+			assert.Nil(t, err)
+
+			msgBody := &queue.QueueMessageSentBody{}
+			assert.Nil(t, json.Unmarshal(retryBody, msgBody))
+
+			assert.Equal(t, 1, msgBody.ID)
+			assert.Equal(t, tt.wantTimesRetried, msgBody.TimesRetried)
+			assert.Equal(t, tt.lastErr.Error(), msgBody.LastError)
+		})
+	}
+
+	_, err := retryMessageBody([]byte("not json"), errors.New("execution reverted"), true)
+	assert.NotNil(t, err)
+}
+
+func Test_deadLetterMessage(t *testing.T) {
+	p := newTestProcessor(true)
+
+	marshalled, err := json.Marshal(queue.QueueMessageSentBody{
+		Event: &bridge.BridgeMessageSent{
+			MsgHash: mock.SuccessMsgHash,
+			Raw: types.Log{
+				Address: relayer.ZeroAddress,
+				Topics: []common.Hash{
+					relayer.ZeroHash,
+				},
+				Data: []byte{0xff},
+			},
+		},
+		ID:           1,
+		TimesRetried: p.maxMessageRetries,
+		LastError:    "execution reverted",
+	})
+	assert.Nil(t, err)
+
+	assert.Nil(t, p.deadLetterMessage(context.Background(), queue.Message{
+		Body: marshalled,
+	}))
+
+	msgHash := common.Hash(mock.SuccessMsgHash).Hex()
+
+	msgs, err := p.deadLetterRepo.Find(context.Background(), relayer.FindDeadLetterMessagesOpts{
+		MsgHash: &msgHash,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(msgs))
+	assert.Equal(t, 1, msgs[0].MessageID)
+	assert.Equal(t, p.maxMessageRetries, msgs[0].TimesRetried)
+	assert.Equal(t, "execution reverted", msgs[0].LastError)
+	assert.Equal(t, marshalled, []byte(msgs[0].Body))
+
+	assert.NotNil(t, p.deadLetterMessage(context.Background(), queue.Message{
+		Body: []byte("{}"),
+	}))
+}
+
+func Test_unprofitableQueueExpiration(t *testing.T) {
+	configured := "1000"
+
+	tests := []struct {
+		name       string
+		expiration *string
+		want       string
+	}{
+		{
+			"default",
+			nil,
+			defaultRetryDelay,
+		},
+		{
+			"configured",
+			&configured,
+			configured,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestProcessor(true)
+			p.cfg.UnprofitableMessageQueueExpiration = tt.expiration
+
+			assert.Equal(t, tt.want, *p.unprofitableQueueExpiration())
+		})
+	}
+}
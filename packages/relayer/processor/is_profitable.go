@@ -3,6 +3,7 @@ package processor
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/taikoxyz/taiko-mono/packages/relayer"
@@ -12,62 +13,35 @@ var (
 	errImpossible = errors.New("impossible to process")
 )
 
-// isProfitable determines whether a message is profitable or not. It should
-// check the processing fee, if one does not exist at all, it is definitely not
-// profitable. Otherwise, we compare it to the estimated cost.
+// isProfitable determines whether a message is profitable or not, using the
+// processor's ProfitabilityEvaluator, and records the outcome on the event.
 func (p *Processor) isProfitable(
 	ctx context.Context,
 	id int,
-	fee uint64,
-	gasLimit uint64,
-	destChainBaseFee uint64,
-	gasTipCap uint64,
-) (bool, error) {
-	var shouldProcess bool = false
-
-	if fee == 0 || gasLimit == 0 {
-		slog.Info("unprofitable: no gasLimit or processingFee",
-			"processingFee", fee,
-			"gasLimit", gasLimit,
-		)
-
-		return shouldProcess, errImpossible
-	}
-
-	// if processing fee is higher than baseFee * gasLimit,
-	// we should process.
-	estimatedOnchainFee := (destChainBaseFee + gasTipCap) * uint64(gasLimit)
-	if fee > estimatedOnchainFee {
-		shouldProcess = true
+	opts EvaluateProfitabilityOpts,
+) (*Profitability, error) {
+	profitability, err := p.profitabilityEvaluator.Evaluate(ctx, opts)
+	if err != nil {
+		return nil, err
 	}
 
-	slog.Info("isProfitable",
-		"processingFee", fee,
-		"destChainBaseFee", destChainBaseFee,
-		"gasTipCap", gasTipCap,
-		"gasLimit", gasLimit,
-		"shouldProcess", shouldProcess,
-		"estimatedOnchainFee", estimatedOnchainFee,
-	)
-
-	opts := relayer.UpdateFeesAndProfitabilityOpts{
-		Fee:                 fee,
-		DestChainBaseFee:    destChainBaseFee,
-		GasTipCap:           gasTipCap,
-		GasLimit:            gasLimit,
-		IsProfitable:        shouldProcess,
-		EstimatedOnchainFee: estimatedOnchainFee,
+	updateOpts := relayer.UpdateFeesAndProfitabilityOpts{
+		Fee:                     opts.Message.Fee,
+		DestChainBaseFee:        opts.BaseFee.Uint64(),
+		GasTipCap:               opts.GasTipCap.Uint64(),
+		GasLimit:                opts.GasLimit,
+		IsProfitable:            profitability.IsProfitable,
+		EstimatedOnchainFee:     profitability.EstimatedCost.Uint64(),
+		IsProfitableEvaluatedAt: time.Now().UTC(),
 	}
 
-	if err := p.eventRepo.UpdateFeesAndProfitability(ctx, id, &opts); err != nil {
+	if err := p.eventRepo.UpdateFeesAndProfitability(ctx, id, &updateOpts); err != nil {
 		slog.Error("failed to update event", "error", err)
 	}
 
-	if !shouldProcess {
+	if !profitability.IsProfitable {
 		relayer.UnprofitableMessagesDetected.Inc()
-
-		return false, nil
 	}
 
-	return true, nil
+	return profitability, nil
 }
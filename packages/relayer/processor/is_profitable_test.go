@@ -2,51 +2,55 @@ package processor
 
 import (
 	"context"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
 )
 
 func Test_isProfitable(t *testing.T) {
 	p := newTestProcessor(true)
 
+	_, err := p.eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+		Name:        relayer.EventNameMessageSent,
+		Data:        "{}",
+		ChainID:     big.NewInt(1),
+		DestChainID: big.NewInt(2),
+		MsgHash:     "0x1",
+	})
+	assert.Nil(t, err)
+
+	event, err := p.eventRepo.FirstByMsgHash(context.Background(), "0x1")
+	assert.Nil(t, err)
+
 	tests := []struct {
-		id             int
 		name           string
 		fee            uint64
 		gasLimit       uint64
-		baseFee        uint64
-		gasTipCap      uint64
 		wantProfitable bool
 		wantErr        error
 	}{
 		{
-			0,
 			"zeroProcessingFee",
 			0,
 			1,
-			1,
-			1,
 			false,
 			errImpossible,
 		},
 		{
-			1,
 			"profitable",
-			600000000600001,
+			1000,
 			600000,
-			1000000000,
-			1,
 			true,
 			nil,
 		},
 		{
-			2,
 			"unprofitable",
-			590000000600000,
+			100,
 			600000,
-			1000000000,
-			1,
 			false,
 			nil,
 		},
@@ -54,17 +58,30 @@ func Test_isProfitable(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			profitable, err := p.isProfitable(
+			profitability, err := p.isProfitable(
 				context.Background(),
-				tt.id,
-				tt.fee,
-				tt.gasLimit,
-				tt.baseFee,
-				tt.gasTipCap,
+				event.ID,
+				EvaluateProfitabilityOpts{
+					Message:   bridge.IBridgeMessage{Fee: tt.fee},
+					GasLimit:  tt.gasLimit,
+					BaseFee:   big.NewInt(400),
+					GasTipCap: big.NewInt(100),
+				},
 			)
 
-			assert.Equal(t, tt.wantProfitable, profitable)
 			assert.Equal(t, tt.wantErr, err)
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			assert.Equal(t, tt.wantProfitable, profitability.IsProfitable)
+
+			// the mock client estimates 1 gas, priced at baseFee + gasTipCap.
+			assert.Equal(t, uint64(500), *event.EstimatedOnchainFee)
+			assert.Equal(t, tt.wantProfitable, *event.IsProfitable)
+			assert.Equal(t, tt.fee, *event.Fee)
+			assert.NotNil(t, event.IsProfitableEvaluatedAt)
 		})
 	}
 }
@@ -0,0 +1,33 @@
+package processor
+
+import (
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
+)
+
+// MultiConfig is a struct used to initialize a MultiProcessor.
+type MultiConfig struct {
+	// Processor holds the settings every route shares. Its chain specific
+	// settings are replaced by those of each route.
+	Processor *Config
+	Routes    []Route
+}
+
+// NewMultiConfigFromCliContext creates a new config instance from command line flags.
+func NewMultiConfigFromCliContext(c *cli.Context) (*MultiConfig, error) {
+	processorCfg, err := NewConfigFromCliContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	routes, err := LoadRoutes(c.String(flags.RoutesFile.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	return &MultiConfig{
+		Processor: processorCfg,
+		Routes:    routes,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package processor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
+)
+
+func TestNewMultiConfigFromCliContext(t *testing.T) {
+	app := cli.NewApp()
+	app.Flags = flags.MultiProcessorFlags
+	app.Action = func(ctx *cli.Context) error {
+		c, err := NewMultiConfigFromCliContext(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, "dbuser", c.Processor.DatabaseUsername)
+		assert.Equal(t, uint64(10), c.Processor.Confirmations)
+		assert.Equal(t, true, c.Processor.ProfitableOnly)
+		assert.NotNil(t, c.Processor.ProcessorPrivateKey)
+		assert.Nil(t, c.Processor.TargetTxHash)
+		assert.Equal(t, 2, len(c.Routes))
+		assert.Equal(t, "l3RpcUrl", c.Routes[1].DestRPCUrl)
+
+		return err
+	}
+
+	assert.Nil(t, app.Run([]string{
+		"TestNewMultiConfigFromCliContext",
+		"--" + flags.DatabaseUsername.Name, "dbuser",
+		"--" + flags.DatabasePassword.Name, "dbpass",
+		"--" + flags.DatabaseHost.Name, "dbhost",
+		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.ProcessorPrivateKey.Name, dummyEcdsaKey,
+		"--" + flags.RoutesFile.Name, writeRoutesFile(t, testRoutes),
+		"--" + flags.Confirmations.Name, confirmations,
+		"--" + flags.ProfitableOnly.Name,
+	}))
+}
+
+func TestNewMultiConfigFromCliContext_DatabaseDialect(t *testing.T) {
+	app := cli.NewApp()
+	app.Flags = flags.MultiProcessorFlags
+	app.Action = func(ctx *cli.Context) error {
+		c, err := NewMultiConfigFromCliContext(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, "postgres", c.Processor.DatabaseDialect)
+
+		return err
+	}
+
+	assert.Nil(t, app.Run([]string{
+		"TestNewMultiConfigFromCliContext_DatabaseDialect",
+		"--" + flags.DatabaseUsername.Name, "dbuser",
+		"--" + flags.DatabasePassword.Name, "dbpass",
+		"--" + flags.DatabaseHost.Name, "dbhost",
+		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.DatabaseDialect.Name, "postgres",
+		"--" + flags.ProcessorPrivateKey.Name, dummyEcdsaKey,
+		"--" + flags.RoutesFile.Name, writeRoutesFile(t, testRoutes),
+	}))
+}
+
+func TestNewMultiConfigFromCliContext_RoutesFileError(t *testing.T) {
+	app := cli.NewApp()
+	app.Flags = flags.MultiProcessorFlags
+	app.Action = func(ctx *cli.Context) error {
+		_, err := NewMultiConfigFromCliContext(ctx)
+		return err
+	}
+
+	assert.ErrorContains(t, app.Run([]string{
+		"TestNewMultiConfigFromCliContext_RoutesFileError",
+		"--" + flags.DatabaseUsername.Name, "dbuser",
+		"--" + flags.DatabasePassword.Name, "dbpass",
+		"--" + flags.DatabaseHost.Name, "dbhost",
+		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.ProcessorPrivateKey.Name, dummyEcdsaKey,
+		"--" + flags.RoutesFile.Name, writeRoutesFile(t, `[]`),
+	}), ErrNoRoutes.Error())
+}
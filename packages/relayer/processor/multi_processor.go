@@ -0,0 +1,216 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	txmgrMetrics "github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+)
+
+// MultiProcessor runs a Processor for each of many routes in one process, each
+// consuming from the queue of its own route. The processors share one DB pool and
+// one RPC connection per URL, and every route with the same destination chain
+// sends through the same TxManager, so nonces of the relayer key are still managed
+// in one place per chain.
+type MultiProcessor struct {
+	db db.DB
+
+	rpcClientsMu sync.Mutex
+	rpcClients   map[string]*rpc.Client
+	dialFunc     func(url string) (*rpc.Client, error)
+
+	txmgrsMu sync.Mutex
+	txmgrs   map[uint64]txmgr.TxManager
+
+	processors []*Processor
+}
+
+// InitFromCli creates a new multi processor from a cli context
+func (m *MultiProcessor) InitFromCli(ctx context.Context, c *cli.Context) error {
+	cfg, err := NewMultiConfigFromCliContext(c)
+	if err != nil {
+		return err
+	}
+
+	return InitMultiProcessorFromConfig(ctx, m, cfg)
+}
+
+func InitMultiProcessorFromConfig(ctx context.Context, m *MultiProcessor, cfg *MultiConfig) (err error) {
+	database, err := cfg.Processor.OpenDBFunc()
+	if err != nil {
+		return err
+	}
+
+	m.db = database
+	m.rpcClients = make(map[string]*rpc.Client)
+	m.dialFunc = cfg.Processor.DialRPCClientFunc
+	m.txmgrs = make(map[uint64]txmgr.TxManager)
+
+	defer func() {
+		if err != nil {
+			m.closeShared()
+		}
+	}()
+
+	sharedDB := db.NewShared(database)
+
+	queueNames := make(map[string]struct{})
+
+	for i, route := range cfg.Routes {
+		routeCfg := route.config(cfg.Processor)
+
+		routeCfg.OpenDBFunc = func() (db.DB, error) {
+			return sharedDB, nil
+		}
+		routeCfg.DialRPCClientFunc = m.dialRPCClient
+
+		destChainID, err := m.chainID(ctx, route.DestRPCUrl)
+		if err != nil {
+			return fmt.Errorf("route %v: %w", i, err)
+		}
+
+		txmgrConfigs := routeCfg.TxmgrConfigs
+
+		routeCfg.TxManagerFunc = func() (txmgr.TxManager, error) {
+			return m.txManager(destChainID, txmgrConfigs)
+		}
+
+		p := new(Processor)
+		if err := InitFromConfig(ctx, p, routeCfg); err != nil {
+			return fmt.Errorf("route %v: %w", i, err)
+		}
+
+		// two processors consuming from the same queue would process the same
+		// messages twice.
+		if _, ok := queueNames[p.queueName()]; ok {
+			return fmt.Errorf("duplicate route from chain %v to chain %v", p.srcChainId, p.destChainId)
+		}
+
+		queueNames[p.queueName()] = struct{}{}
+
+		m.processors = append(m.processors, p)
+	}
+
+	return nil
+}
+
+// dialRPCClient returns the RPC client for url, dialing it the first time it is requested.
+func (m *MultiProcessor) dialRPCClient(url string) (*rpc.Client, error) {
+	m.rpcClientsMu.Lock()
+	defer m.rpcClientsMu.Unlock()
+
+	if client, ok := m.rpcClients[url]; ok {
+		return client, nil
+	}
+
+	client, err := m.dialFunc(url)
+	if err != nil {
+		return nil, err
+	}
+
+	m.rpcClients[url] = client
+
+	return client, nil
+}
+
+func (m *MultiProcessor) chainID(ctx context.Context, url string) (uint64, error) {
+	client, err := m.dialRPCClient(url)
+	if err != nil {
+		return 0, err
+	}
+
+	chainID, err := ethclient.NewClient(client).ChainID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return chainID.Uint64(), nil
+}
+
+// txManager returns the TxManager for chainID, creating it from cfg the first
+// time it is requested.
+func (m *MultiProcessor) txManager(chainID uint64, cfg *txmgr.CLIConfig) (txmgr.TxManager, error) {
+	m.txmgrsMu.Lock()
+	defer m.txmgrsMu.Unlock()
+
+	if t, ok := m.txmgrs[chainID]; ok {
+		return t, nil
+	}
+
+	t, err := txmgr.NewSimpleTxManager(
+		fmt.Sprintf("processor-%v", chainID),
+		log.Root(),
+		new(txmgrMetrics.NoopTxMetrics),
+		*cfg,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.txmgrs[chainID] = t
+
+	return t, nil
+}
+
+func (m *MultiProcessor) Name() string {
+	return "multiprocessor"
+}
+
+func (m *MultiProcessor) Start() error {
+	for _, p := range m.processors {
+		slog.Info("Starting route processor", "srcChainId", p.srcChainId, "destChainId", p.destChainId)
+
+		if err := p.Start(); err != nil {
+			slog.Error("Starting route processor error",
+				"srcChainId", p.srcChainId,
+				"destChainId", p.destChainId,
+				"error", err,
+			)
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *MultiProcessor) Close(ctx context.Context) {
+	for _, p := range m.processors {
+		if p.cancel == nil {
+			continue
+		}
+
+		p.Close(ctx)
+	}
+
+	m.closeShared()
+}
+
+// closeShared closes the TxManagers, DB pool and RPC clients the processors were sharing.
+func (m *MultiProcessor) closeShared() {
+	m.txmgrsMu.Lock()
+	for _, t := range m.txmgrs {
+		t.Close()
+	}
+	m.txmgrsMu.Unlock()
+
+	if err := m.db.Close(); err != nil {
+		slog.Error("Failed to close db connection", "err", err)
+	}
+
+	m.rpcClientsMu.Lock()
+	defer m.rpcClientsMu.Unlock()
+
+	for _, client := range m.rpcClients {
+		client.Close()
+	}
+}
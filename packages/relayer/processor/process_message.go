@@ -11,8 +11,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/ethereum-optimism/optimism/op-service/txmgr"
-	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -31,6 +29,10 @@ var (
 	zeroAddress          = common.HexToAddress("0x0000000000000000000000000000000000000000")
 	errUnprocessable     = errors.New("message is unprocessable")
 	errAlreadyProcessing = errors.New("already processing txHash")
+	errMaxRetriesReached = errors.New("max retries reached")
+	// errProcessingAttemptFailed marks the errors of an actual attempt to process a message
+	// on the destination chain, the only errors which count towards maxMessageRetries.
+	errProcessingAttemptFailed = errors.New("processing attempt failed")
 )
 
 // eventStatusFromMsgHash will check the event's msgHash/signal, and
@@ -106,14 +108,41 @@ func (p *Processor) processMessage(
 	}(msgBody.Event.Raw.TxHash)
 
 	if msgBody.TimesRetried >= p.maxMessageRetries {
-		slog.Warn("max retries reached", "timesRetried", msgBody.TimesRetried)
+		slog.Warn("max retries reached",
+			"timesRetried", msgBody.TimesRetried,
+			"lastError", msgBody.LastError,
+			"srcTxHash", msgBody.Event.Raw.TxHash.Hex(),
+		)
 
-		return false, msgBody.TimesRetried, nil
+		return false, msgBody.TimesRetried, errMaxRetriesReached
+	}
+
+	// suspended messages should not be processed until they are unsuspended
+	suspended, err := p.suspendedTxRepo.IsSuspended(ctx, common.Hash(msgBody.Event.MsgHash).Hex())
+	if err != nil {
+		return false, msgBody.TimesRetried, errors.Wrap(err, "p.suspendedTxRepo.IsSuspended")
+	}
+
+	if suspended {
+		slog.Warn("message is suspended",
+			"msgHash", common.Hash(msgBody.Event.MsgHash).Hex(),
+			"srcTxHash", msgBody.Event.Raw.TxHash.Hex(),
+		)
+
+		relayer.SuspendedMessagesSkipped.Inc()
+
+		return false, msgBody.TimesRetried, relayer.ErrSuspended
+	}
+
+	// sponsored messages are processed regardless of their fee.
+	sponsor, err := p.sponsoringPolicy(ctx, msgBody.Event)
+	if err != nil {
+		return false, msgBody.TimesRetried, errors.Wrap(err, "p.sponsoringPolicy")
 	}
 
 	// we never want to process messages below a certain fee, if set.
 	// return a nil error, and we will successfully acknowledge this.
-	if p.minFeeToProcess != 0 && msgBody.Event.Message.Fee < p.minFeeToProcess {
+	if sponsor == nil && p.minFeeToProcess != 0 && msgBody.Event.Message.Fee < p.minFeeToProcess {
 		slog.Warn("minFeeToProcess not met",
 			"minFeeToProcess", p.minFeeToProcess,
 			"fee", msgBody.Event.Message.Fee,
@@ -197,12 +226,12 @@ func (p *Processor) processMessage(
 		}
 	}
 
-	encodedSignalProof, err := p.generateEncodedSignalProof(ctx, msgBody.Event)
+	encodedSignalProof, proofBlockNumber, err := p.generateEncodedSignalProof(ctx, msgBody.Event)
 	if err != nil {
 		return false, msgBody.TimesRetried, err
 	}
 
-	_, err = p.sendProcessMessageCall(ctx, msgBody.ID, msgBody.Event, encodedSignalProof)
+	_, err = p.sendProcessMessageCall(ctx, msgBody.ID, msgBody.Event, encodedSignalProof, proofBlockNumber, sponsor)
 	if err != nil {
 		return false, msgBody.TimesRetried, err
 	}
@@ -240,9 +269,10 @@ func (p *Processor) processMessage(
 
 // generateEncodedSignalproof takes a MessageSent event and calls a
 // proof generation service to generate a proof for the source call
-// as well as any additional hops required.
+// as well as any additional hops required. It also returns the block
+// the proof is generated against.
 func (p *Processor) generateEncodedSignalProof(ctx context.Context,
-	event *bridge.BridgeMessageSent) ([]byte, error) {
+	event *bridge.BridgeMessageSent) ([]byte, uint64, error) {
 	var encodedSignalProof []byte
 
 	var err error
@@ -262,11 +292,11 @@ func (p *Processor) generateEncodedSignalProof(ctx context.Context,
 			event, err := p.waitHeaderSynced(ctx, hopEthClient, hop.chainID.Uint64(), blockNum)
 
 			if err != nil {
-				return nil, errors.Wrap(err, "p.waitHeaderSynced")
+				return nil, 0, errors.Wrap(err, "p.waitHeaderSynced")
 			}
 
 			if err != nil {
-				return nil, errors.Wrap(err, "hop.headerSyncer.GetSyncedSnippet")
+				return nil, 0, errors.Wrap(err, "hop.headerSyncer.GetSyncedSnippet")
 			}
 
 			blockNum = event.SyncedInBlockID
@@ -278,13 +308,13 @@ func (p *Processor) generateEncodedSignalProof(ctx context.Context,
 
 		event, err := p.waitHeaderSynced(ctx, hopEthClient, hopChainID.Uint64(), blockNum)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 
 		blockNum = event.SyncedInBlockID
 	} else {
 		if _, err := p.waitHeaderSynced(ctx, p.srcEthClient, p.destChainId.Uint64(), event.Raw.BlockNumber); err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 	}
 
@@ -297,7 +327,7 @@ func (p *Processor) generateEncodedSignalProof(ctx context.Context,
 	)
 
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	// if we have no hops, this is strictly a srcChain => destChain message.
@@ -310,11 +340,12 @@ func (p *Processor) generateEncodedSignalProof(ctx context.Context,
 			p.srcChainId.Uint64(),
 		)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 
 		hops = append(hops, proof.HopParams{
 			ChainID:              p.destChainId,
+			SrcChainID:           p.srcChainId,
 			SignalServiceAddress: p.srcSignalServiceAddress,
 			Blocker:              p.srcEthClient,
 			Caller:               p.srcCaller,
@@ -324,9 +355,10 @@ func (p *Processor) generateEncodedSignalProof(ctx context.Context,
 		})
 	} else {
 		// otherwise, we should just create the first hop in the array, we will append
-		// the rest of the hops after.
+		// the rest of the hops after. it proves to the first intermediary chain.
 		hops = append(hops, proof.HopParams{
-			ChainID:              p.destChainId,
+			ChainID:              p.hops[0].chainID,
+			SrcChainID:           p.srcChainId,
 			SignalServiceAddress: p.srcSignalServiceAddress,
 			Blocker:              p.srcEthClient,
 			Caller:               p.srcCaller,
@@ -339,7 +371,7 @@ func (p *Processor) generateEncodedSignalProof(ctx context.Context,
 	// if a hop is set, the proof service needs to generate an additional proof
 	// for the signal service intermediary chain in between the source chain
 	// and the destination chain.
-	for _, hop := range p.hops {
+	for i, hop := range p.hops {
 		slog.Info(
 			"adding hop",
 			"hopChainId", hop.chainID.Uint64(),
@@ -351,7 +383,7 @@ func (p *Processor) generateEncodedSignalProof(ctx context.Context,
 			new(big.Int).SetUint64(blockNum),
 		)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 
 		hopStorageSlotKey, err := hop.signalService.GetSignalSlot(&bind.CallOpts{
@@ -362,11 +394,18 @@ func (p *Processor) generateEncodedSignalProof(ctx context.Context,
 			block.Root(),
 		)
 		if err != nil {
-			return nil, errors.Wrap(err, "hopSignalService.GetSignalSlot")
+			return nil, 0, errors.Wrap(err, "hopSignalService.GetSignalSlot")
+		}
+
+		// each hop proves to the chain of the next one, and the last to the destination chain.
+		hopDestChainID := p.destChainId
+		if i < len(p.hops)-1 {
+			hopDestChainID = p.hops[i+1].chainID
 		}
 
 		hops = append(hops, proof.HopParams{
-			ChainID:              hop.chainID,
+			ChainID:              hopDestChainID,
+			SrcChainID:           hop.chainID,
 			SignalServiceAddress: hop.signalServiceAddress,
 			Blocker:              hop.ethClient,
 			Caller:               hop.caller,
@@ -378,6 +417,7 @@ func (p *Processor) generateEncodedSignalProof(ctx context.Context,
 
 	encodedSignalProof, err = p.prover.EncodedSignalProofWithHops(
 		ctx,
+		p.destSignalService,
 		hops,
 	)
 
@@ -394,19 +434,23 @@ func (p *Processor) generateEncodedSignalProof(ctx context.Context,
 			"hopsLength", len(hops),
 		)
 
-		return nil, err
+		return nil, 0, err
 	}
 
-	return encodedSignalProof, nil
+	return encodedSignalProof, hops[0].BlockNumber, nil
 }
 
 // sendProcessMessageCall calls `bridge.processMessage` with latest nonce
-// after estimating gas, and checking profitability.
+// after estimating gas, and checking profitability, unless the message is
+// sponsored. When batching is enabled, the call is sent along with the others
+// proven against the same block.
 func (p *Processor) sendProcessMessageCall(
 	ctx context.Context,
 	id int,
 	event *bridge.BridgeMessageSent,
 	proof []byte,
+	proofBlockNumber uint64,
+	sponsor *SponsorshipPolicy,
 ) (*types.Receipt, error) {
 	defer p.logRelayerBalance(ctx)
 
@@ -447,52 +491,33 @@ func (p *Processor) sendProcessMessageCall(
 
 	var estimatedCost uint64 = 0
 
-	if bool(p.profitableOnly) {
-		profitable, err := p.isProfitable(
-			ctx,
-			id,
-			event.Message.Fee,
-			gasLimit,
-			baseFee.Uint64(),
-			gasTipCap.Uint64(),
-		)
-		if err != nil || !profitable {
-			if err == errImpossible {
-				return nil, errImpossible
-			}
-
-			return nil, relayer.ErrUnprofitable
-		}
-
-		// now simulate the transaction and lets confirm it is profitable
-		auth, err := bind.NewKeyedTransactorWithChainID(p.ecdsaKey, p.destChainId)
+	if bool(p.profitableOnly) && sponsor == nil {
+		profitability, err := p.isProfitable(ctx, id, EvaluateProfitabilityOpts{
+			Message:   event.Message,
+			From:      p.relayerAddr,
+			To:        p.cfg.DestBridgeAddress,
+			Data:      data,
+			GasLimit:  gasLimit,
+			BaseFee:   baseFee,
+			GasTipCap: gasTipCap,
+		})
 		if err != nil {
 			return nil, err
 		}
 
-		msg := ethereum.CallMsg{
-			From: auth.From,
-			To:   &p.cfg.DestBridgeAddress,
-			Data: data,
-		}
-
-		gasUsed, err := p.destEthClient.EstimateGas(context.Background(), msg)
-		if err != nil {
-			return nil, err
+		if !profitability.IsProfitable {
+			return nil, relayer.ErrUnprofitable
 		}
 
 		slog.Info("estimatedGasUsed",
-			"gasUsed", gasUsed,
+			"gasUsed", profitability.GasUsed,
 			"messageGasLimit", event.Message.GasLimit,
 			"paddedGasLimit", gasLimit,
 			"srcTxHash", event.Raw.TxHash.Hex(),
 		)
 
-		if gasUsed > gasLimit {
-			return nil, relayer.ErrUnprofitable
-		}
-
-		estimatedCost = gasUsed * (baseFee.Uint64() + gasTipCap.Uint64())
+		// the receipt only reflects the execution cost, so that is what it is compared with.
+		estimatedCost = profitability.ExecutionCost.Uint64()
 	}
 
 	// we should check event status one more time, after we have waiting for
@@ -515,37 +540,41 @@ func (p *Processor) sendProcessMessageCall(
 		return nil, errUnprocessable
 	}
 
-	candidate := txmgr.TxCandidate{
-		TxData:   data,
-		Blobs:    nil,
-		To:       &p.cfg.DestBridgeAddress,
-		GasLimit: gasLimit,
-	}
-
-	receipt, err := p.txmgr.Send(ctx, candidate)
-	if err != nil {
-		slog.Warn("Failed to send ProcessMessage transaction", "error", err.Error())
-		return nil, err
+	call := &processMessageCall{
+		event:            event,
+		data:             data,
+		gasLimit:         gasLimit,
+		proofBlockNumber: proofBlockNumber,
 	}
 
-	slog.Info("Mined tx",
-		"txHash", hex.EncodeToString(receipt.TxHash.Bytes()),
-		"srcTxHash", event.Raw.TxHash.Hex(),
-	)
+	var result processMessageResult
 
-	if receipt.Status != types.ReceiptStatusSuccessful {
-		relayer.MessageSentEventsProcessedReverted.Inc()
-		slog.Warn("Transaction reverted", "txHash", hex.EncodeToString(receipt.TxHash.Bytes()),
-			"srcTxHash", event.Raw.TxHash.Hex(),
-			"status", receipt.Status)
+	if p.batcher != nil {
+		result = p.batcher.add(ctx, call)
+	} else {
+		result = p.sendProcessMessageCalls(ctx, []*processMessageCall{call})[0]
+	}
 
-		return nil, errTxReverted
+	if result.err != nil {
+		return nil, fmt.Errorf("%w: %w", errProcessingAttemptFailed, result.err)
 	}
 
+	receipt := result.receipt
+
 	relayer.MessageSentEventsProcessed.Inc()
 
-	if p.profitableOnly {
-		cost := receipt.GasUsed * receipt.EffectiveGasPrice.Uint64()
+	if err := p.eventRepo.UpdateProcessingGasUsed(ctx, id, &relayer.UpdateProcessingGasUsedOpts{
+		GasUsed:     result.gasUsed,
+		ProcessedAt: time.Now().UTC(),
+	}); err != nil {
+		slog.Error("failed to record processing gas used", "error", err)
+	}
+
+	if sponsor != nil {
+		p.recordSponsoredGas(ctx, sponsor, event, result.gasUsed)
+	} else if p.profitableOnly {
+		// a batched transaction's cost is shared by the messages it processed, see processMessageResult.
+		cost := result.gasUsed * receipt.EffectiveGasPrice.Uint64()
 
 		slog.Info("tx cost", "txHash", hex.EncodeToString(receipt.TxHash.Bytes()),
 			"srcTxHash", event.Raw.TxHash.Hex(),
@@ -604,9 +633,9 @@ func (p *Processor) saveMessageStatusChangedEvent(
 
 	m := make(map[string]interface{})
 
+	// a batched transaction emits a MessageStatusChanged event for each of its messages.
 	for _, log := range receipt.Logs {
-		topic := log.Topics[0]
-		if topic == bridgeAbi.Events["MessageStatusChanged"].ID {
+		if isMessageStatusChangedLog(log, bridgeAbi.Events["MessageStatusChanged"].ID, event.MsgHash) {
 			err = bridgeAbi.UnpackIntoMap(m, "MessageStatusChanged", log.Data)
 			if err != nil {
 				return err
@@ -42,7 +42,7 @@ func Test_sendProcessMessageCall(t *testing.T) {
 				},
 				Data: []byte{0xff},
 			},
-		}, []byte{})
+		}, []byte{}, 1, nil)
 
 	assert.Equal(t, err, errUnprocessable)
 }
@@ -128,3 +128,80 @@ func Test_ProcessMessage_unprofitable(t *testing.T) {
 
 	assert.False(t, shouldRequeue)
 }
+
+func Test_ProcessMessage_suspended(t *testing.T) {
+	p := newTestProcessor(true)
+
+	_, err := p.suspendedTxRepo.Suspend(context.Background(), relayer.SuspendTransactionOpts{
+		MessageID: 1,
+		MsgHash:   common.Hash(mock.SuccessMsgHash).Hex(),
+	})
+	assert.Nil(t, err)
+
+	body := queue.QueueMessageSentBody{
+		Event: &bridge.BridgeMessageSent{
+			Message: bridge.IBridgeMessage{
+				Id:         1,
+				SrcChainId: mock.MockChainID.Uint64(),
+				GasLimit:   600000,
+				Fee:        1,
+			},
+			MsgHash: mock.SuccessMsgHash,
+			Raw: types.Log{
+				Address: relayer.ZeroAddress,
+				Topics: []common.Hash{
+					relayer.ZeroHash,
+				},
+				Data: []byte{0xff},
+			},
+		},
+		ID: 0,
+	}
+
+	marshalled, err := json.Marshal(body)
+	assert.Nil(t, err)
+
+	shouldRequeue, _, err := p.processMessage(context.Background(), queue.Message{
+		Body: marshalled,
+	})
+
+	assert.Equal(t, relayer.ErrSuspended, err)
+	assert.False(t, shouldRequeue)
+}
+
+func Test_ProcessMessage_maxRetriesReached(t *testing.T) {
+	p := newTestProcessor(true)
+
+	body := queue.QueueMessageSentBody{
+		Event: &bridge.BridgeMessageSent{
+			Message: bridge.IBridgeMessage{
+				Id:         1,
+				SrcChainId: mock.MockChainID.Uint64(),
+				GasLimit:   600000,
+				Fee:        1,
+			},
+			MsgHash: mock.SuccessMsgHash,
+			Raw: types.Log{
+				Address: relayer.ZeroAddress,
+				Topics: []common.Hash{
+					relayer.ZeroHash,
+				},
+				Data: []byte{0xff},
+			},
+		},
+		ID:           0,
+		TimesRetried: p.maxMessageRetries,
+		LastError:    relayer.ErrUnprofitable.Error(),
+	}
+
+	marshalled, err := json.Marshal(body)
+	assert.Nil(t, err)
+
+	shouldRequeue, timesRetried, err := p.processMessage(context.Background(), queue.Message{
+		Body: marshalled,
+	})
+
+	assert.Equal(t, errMaxRetriesReached, err)
+	assert.False(t, shouldRequeue)
+	assert.Equal(t, p.maxMessageRetries, timesRetried)
+}
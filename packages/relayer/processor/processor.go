@@ -74,7 +74,11 @@ type hop struct {
 type Processor struct {
 	cancel context.CancelFunc
 
-	eventRepo relayer.EventRepository
+	eventRepo       relayer.EventRepository
+	suspendedTxRepo relayer.SuspendedTransactionRepository
+	deadLetterRepo  relayer.DeadLetterMessageRepository
+
+	sponsoredGasUsageRepo relayer.SponsoredGasUsageRepository
 
 	queue queue.Queue
 
@@ -87,6 +91,8 @@ type Processor struct {
 	ecdsaKey *ecdsa.PrivateKey
 
 	srcSignalService relayer.SignalService
+	// destSignalService is optional, and only used to look up cached roots.
+	destSignalService relayer.SignalService
 
 	destBridge       relayer.Bridge
 	destERC20Vault   relayer.TokenVault
@@ -102,6 +108,7 @@ type Processor struct {
 	confirmations uint64
 
 	profitableOnly            bool
+	profitabilityEvaluator    ProfitabilityEvaluator
 	headerSyncIntervalSeconds int64
 
 	confTimeoutInSeconds int64
@@ -131,6 +138,11 @@ type Processor struct {
 	processingTxHashMu sync.Mutex
 
 	minFeeToProcess uint64
+
+	// batcher is only set when batching is enabled.
+	batcher *batcher
+
+	sponsorshipPolicies []SponsorshipPolicy
 }
 
 // InitFromCli creates a new processor from a cli context
@@ -157,21 +169,35 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 		return err
 	}
 
-	srcRpcClient, err := rpc.Dial(cfg.SrcRPCUrl)
+	suspendedTxRepository, err := repo.NewSuspendedTransactionRepository(db)
+	if err != nil {
+		return err
+	}
+
+	deadLetterRepository, err := repo.NewDeadLetterMessageRepository(db)
 	if err != nil {
 		return err
 	}
 
-	srcEthClient, err := ethclient.Dial(cfg.SrcRPCUrl)
+	sponsoredGasUsageRepository, err := repo.NewSponsoredGasUsageRepository(db)
 	if err != nil {
 		return err
 	}
 
-	destEthClient, err := ethclient.Dial(cfg.DestRPCUrl)
+	srcRpcClient, err := cfg.DialRPCClientFunc(cfg.SrcRPCUrl)
 	if err != nil {
 		return err
 	}
 
+	srcEthClient := ethclient.NewClient(srcRpcClient)
+
+	destRpcClient, err := cfg.DialRPCClientFunc(cfg.DestRPCUrl)
+	if err != nil {
+		return err
+	}
+
+	destEthClient := ethclient.NewClient(destRpcClient)
+
 	hops := []hop{}
 
 	// iteraate over all the hop configs and create a hop struct
@@ -185,11 +211,13 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 
 		var hopSignalService *signalservice.SignalService
 
-		hopEthClient, err = ethclient.Dial(hopConfig.rpcURL)
+		hopRpcClient, err = cfg.DialRPCClientFunc(hopConfig.rpcURL)
 		if err != nil {
 			return err
 		}
 
+		hopEthClient = ethclient.NewClient(hopRpcClient)
+
 		hopChainID, err = hopEthClient.ChainID(context.Background())
 		if err != nil {
 			return err
@@ -203,11 +231,6 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 			return err
 		}
 
-		hopRpcClient, err = rpc.Dial(hopConfig.rpcURL)
-		if err != nil {
-			return err
-		}
-
 		// only support one hop rn, add in array configs
 		// to support more.
 		hops = append(hops, hop{
@@ -228,6 +251,18 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 		return err
 	}
 
+	if cfg.DestSignalServiceAddress != relayer.ZeroAddress {
+		destSignalService, err := signalservice.NewSignalService(
+			cfg.DestSignalServiceAddress,
+			destEthClient,
+		)
+		if err != nil {
+			return err
+		}
+
+		p.destSignalService = destSignalService
+	}
+
 	destERC20Vault, err := erc20vault.NewERC20Vault(
 		cfg.DestERC20VaultAddress,
 		destEthClient,
@@ -316,7 +351,11 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 		}
 	}
 
-	if p.txmgr, err = txmgr.NewSimpleTxManager(
+	if cfg.TxManagerFunc != nil {
+		if p.txmgr, err = cfg.TxManagerFunc(); err != nil {
+			return err
+		}
+	} else if p.txmgr, err = txmgr.NewSimpleTxManager(
 		"processor",
 		log.Root(),
 		new(txmgrMetrics.NoopTxMetrics),
@@ -328,6 +367,9 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 	p.hops = hops
 	p.prover = prover
 	p.eventRepo = eventRepository
+	p.suspendedTxRepo = suspendedTxRepository
+	p.deadLetterRepo = deadLetterRepository
+	p.sponsoredGasUsageRepo = sponsoredGasUsageRepository
 
 	p.srcEthClient = srcEthClient
 	p.destEthClient = destEthClient
@@ -344,6 +386,15 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 
 	p.profitableOnly = cfg.ProfitableOnly
 
+	p.profitabilityEvaluator = cfg.ProfitabilityEvaluator
+	if p.profitabilityEvaluator == nil {
+		p.profitabilityEvaluator = NewDefaultProfitabilityEvaluator(
+			destEthClient,
+			cfg.L1FeeOracleAddress,
+			cfg.ProfitMargin,
+		)
+	}
+
 	p.queue = q
 
 	p.srcChainId = srcChainID
@@ -370,8 +421,14 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 
 	p.minFeeToProcess = p.cfg.MinFeeToProcess
 
+	if cfg.BatchWindow > 0 && cfg.DestBatchProcessorAddress == relayer.ZeroAddress {
+		return ErrNoBatchProcessorAddress
+	}
+
 	slog.Info("minFeeToProcess", "minFeeToProcess", p.minFeeToProcess)
 
+	p.sponsorshipPolicies = cfg.SponsorshipPolicies
+
 	return nil
 }
 
@@ -408,6 +465,12 @@ func (p *Processor) Start() error {
 	// otherwise, we can start the queue, and process messages from it
 	// via eventloop.
 
+	// batches are sent with the processor's context rather than the one of the message
+	// which started them, so they are only canceled when the processor is closed.
+	if p.cfg.BatchWindow > 0 {
+		p.batcher = newBatcher(ctx, p.cfg.BatchWindow, p.cfg.BatchMaxSize, p.sendProcessMessageCalls)
+	}
+
 	if err := p.queue.Start(ctx, p.queueName()); err != nil {
 		slog.Error("error starting queue", "error", err)
 
@@ -442,7 +505,7 @@ func (p *Processor) Start() error {
 }
 
 func (p *Processor) queueName() string {
-	return fmt.Sprintf("%v-%v-%v-queue", p.srcChainId.String(), p.destChainId.String(), relayer.EventNameMessageSent)
+	return queue.Name(p.srcChainId, p.destChainId, relayer.EventNameMessageSent)
 }
 
 // eventLoop is the main event loop of a Processor which should read
@@ -465,19 +528,53 @@ func (p *Processor) eventLoop(ctx context.Context) {
 						if err := p.queue.Ack(ctx, m); err != nil {
 							slog.Error("Err acking message", "err", err.Error())
 						}
-					case errors.Is(err, relayer.ErrUnprofitable):
-						slog.Info("publishing to unprofitable queue")
+					case errors.Is(err, errMaxRetriesReached):
+						// the message has been retried as many times as we allow, so it is recorded
+						// with its last error and moved to the dead letter queue, from where
+						// it can be replayed.
+						if err := p.deadLetterMessage(ctx, m); err != nil {
+							slog.Error("error dead-lettering message", "err", err.Error())
+
+							if err := p.queue.Nack(ctx, m, false); err != nil {
+								slog.Error("Err nacking message", "err", err.Error())
+							}
+
+							return
+						}
+
+						if err := p.queue.Ack(ctx, m); err != nil {
+							slog.Error("Err acking message", "err", err.Error())
+						}
+					// suspended messages are parked in the unprofitable queue as well, so they
+					// will be checked again after expiration, in case they have been unsuspended.
+					// unprofitability counts towards maxMessageRetries, like failed processing
+					// attempts, while suspension and transient errors do not.
+					case errors.Is(err, relayer.ErrUnprofitable) || errors.Is(err, relayer.ErrSuspended):
+						slog.Info("publishing to unprofitable queue", "err", err.Error())
 
 						headers := make(map[string]interface{}, 0)
 
-						headers["retries"] = int64(timesRetried + 1)
+						headers["retries"] = int64(timesRetried)
+
+						body := m.Body
+
+						if errors.Is(err, relayer.ErrUnprofitable) {
+							headers["retries"] = int64(timesRetried + 1)
+
+							retryBody, marshalErr := retryMessageBody(m.Body, err, true)
+							if marshalErr != nil {
+								slog.Error("err marshaling queue message", "err", marshalErr.Error())
+							} else {
+								body = retryBody
+							}
+						}
 
 						if err := p.queue.Publish(
 							ctx,
 							fmt.Sprintf("%v-unprofitable", p.queueName()),
-							m.Body,
+							body,
 							headers,
-							p.cfg.UnprofitableMessageQueueExpiration,
+							p.unprofitableQueueExpiration(),
 						); err != nil {
 							slog.Error("error publishing to unprofitable queue", "error", err)
 						}
@@ -498,10 +595,28 @@ func (p *Processor) eventLoop(ctx context.Context) {
 					default:
 						slog.Error("process message failed", "err", err.Error())
 
-						// we want to negatively acknowledge the message and requeue it if we
-						// encountered an error, but the message is processable.
-						if err := p.queue.Nack(ctx, m, shouldRequeue); err != nil {
-							slog.Error("Err nacking message", "err", err.Error())
+						if shouldRequeue {
+							if err := p.queue.Nack(ctx, m, true); err != nil {
+								slog.Error("Err nacking message", "err", err.Error())
+							}
+
+							return
+						}
+
+						// the message is processable, so it is retried after a delay, with the
+						// error recorded, until its failed attempts reach maxMessageRetries.
+						if err := p.retryMessage(ctx, m, err); err != nil {
+							slog.Error("error retrying message", "err", err.Error())
+
+							if err := p.queue.Nack(ctx, m, false); err != nil {
+								slog.Error("Err nacking message", "err", err.Error())
+							}
+
+							return
+						}
+
+						if err := p.queue.Ack(ctx, m); err != nil {
+							slog.Error("Err acking message", "err", err.Error())
 						}
 					}
 
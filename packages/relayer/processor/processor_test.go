@@ -5,6 +5,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/proof"
@@ -22,6 +23,9 @@ func newTestProcessor(profitableOnly bool) *Processor {
 
 	return &Processor{
 		eventRepo:                 &mock.EventRepository{},
+		suspendedTxRepo:           mock.NewSuspendedTransactionRepository(),
+		deadLetterRepo:            mock.NewDeadLetterMessageRepository(),
+		sponsoredGasUsageRepo:     mock.NewSponsoredGasUsageRepository(),
 		destBridge:                &mock.Bridge{},
 		srcEthClient:              &mock.EthClient{},
 		destEthClient:             &mock.EthClient{},
@@ -31,6 +35,7 @@ func newTestProcessor(profitableOnly bool) *Processor {
 		prover:                    prover,
 		srcCaller:                 &mock.Caller{},
 		profitableOnly:            profitableOnly,
+		profitabilityEvaluator:    NewDefaultProfitabilityEvaluator(&mock.EthClient{}, relayer.ZeroAddress, 0),
 		headerSyncIntervalSeconds: 1,
 		confTimeoutInSeconds:      900,
 		confirmations:             1,
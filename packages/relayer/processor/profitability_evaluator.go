@@ -0,0 +1,191 @@
+package processor
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/pkg/errors"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+)
+
+// gasPriceOracleABIJSON is the part of the GasPriceOracle predeploy of OP stack chains
+// used to look up the L1 data fee of a transaction.
+const gasPriceOracleABIJSON = `[{"inputs":[{"internalType":"bytes","name":"_data","type":"bytes"}],"name":"getL1Fee",` +
+	`"outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`
+
+var gasPriceOracleABI abi.ABI
+
+func init() {
+	var err error
+
+	gasPriceOracleABI, err = abi.JSON(strings.NewReader(gasPriceOracleABIJSON))
+	if err != nil {
+		log.Crit("Get GasPriceOracle ABI error", "error", err)
+	}
+}
+
+// EvaluateProfitabilityOpts is everything known about a message when it is about to
+// be processed.
+type EvaluateProfitabilityOpts struct {
+	Message bridge.IBridgeMessage
+	// From and To are the sender and the destination bridge of the processMessage
+	// transaction, and Data its calldata.
+	From common.Address
+	To   common.Address
+	Data []byte
+	// GasLimit is the most gas the processMessage transaction will be sent with.
+	GasLimit  uint64
+	BaseFee   *big.Int
+	GasTipCap *big.Int
+}
+
+// Profitability is the outcome of evaluating a message.
+type Profitability struct {
+	IsProfitable bool
+	GasUsed      uint64
+	// ExecutionCost is the gas the transaction is estimated to use, priced at the
+	// destination chain's current base fee and tip.
+	ExecutionCost *big.Int
+	// L1DataFee is what the destination chain charges for posting the transaction
+	// to L1, if it does.
+	L1DataFee *big.Int
+	// EstimatedCost is the sum of ExecutionCost and L1DataFee.
+	EstimatedCost *big.Int
+}
+
+// ProfitabilityEvaluator decides whether the processing fee of a message covers
+// the cost of processing it.
+type ProfitabilityEvaluator interface {
+	Evaluate(ctx context.Context, opts EvaluateProfitabilityOpts) (*Profitability, error)
+}
+
+// profitabilityClient is the part of a destination chain client the default
+// ProfitabilityEvaluator uses.
+type profitabilityClient interface {
+	ethereum.GasEstimator
+	ethereum.ContractCaller
+}
+
+// DefaultProfitabilityEvaluator simulates the processMessage transaction to find the
+// gas it uses, which varies with the kind of message, for instance whether a bridged
+// token has to be deployed. It adds the L1 data fee charged by the GasPriceOracle at
+// l1FeeOracleAddress if one is configured, and requires the fee to exceed the
+// estimated cost by profitMargin percent.
+type DefaultProfitabilityEvaluator struct {
+	client             profitabilityClient
+	l1FeeOracleAddress common.Address
+	profitMargin       uint64
+}
+
+func NewDefaultProfitabilityEvaluator(
+	client profitabilityClient,
+	l1FeeOracleAddress common.Address,
+	profitMargin uint64,
+) *DefaultProfitabilityEvaluator {
+	return &DefaultProfitabilityEvaluator{
+		client:             client,
+		l1FeeOracleAddress: l1FeeOracleAddress,
+		profitMargin:       profitMargin,
+	}
+}
+
+func (e *DefaultProfitabilityEvaluator) Evaluate(
+	ctx context.Context,
+	opts EvaluateProfitabilityOpts,
+) (*Profitability, error) {
+	if opts.Message.Fee == 0 || opts.GasLimit == 0 {
+		slog.Info("unprofitable: no gasLimit or processingFee",
+			"processingFee", opts.Message.Fee,
+			"gasLimit", opts.GasLimit,
+		)
+
+		return nil, errImpossible
+	}
+
+	gasUsed, err := e.client.EstimateGas(ctx, ethereum.CallMsg{
+		From: opts.From,
+		To:   &opts.To,
+		Data: opts.Data,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "e.client.EstimateGas")
+	}
+
+	l1DataFee, err := e.l1DataFee(ctx, opts.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	executionCost := new(big.Int).Mul(
+		new(big.Int).SetUint64(gasUsed),
+		new(big.Int).Add(opts.BaseFee, opts.GasTipCap),
+	)
+
+	estimatedCost := new(big.Int).Add(executionCost, l1DataFee)
+
+	// the fee has to exceed the estimated cost plus the margin.
+	minFee := new(big.Int).Div(
+		new(big.Int).Mul(estimatedCost, new(big.Int).SetUint64(100+e.profitMargin)),
+		big.NewInt(100),
+	)
+
+	// a transaction which needs more gas than the message allows would revert.
+	isProfitable := gasUsed <= opts.GasLimit &&
+		new(big.Int).SetUint64(opts.Message.Fee).Cmp(minFee) > 0
+
+	slog.Info("evaluated profitability",
+		"processingFee", opts.Message.Fee,
+		"gasUsed", gasUsed,
+		"gasLimit", opts.GasLimit,
+		"baseFee", opts.BaseFee,
+		"gasTipCap", opts.GasTipCap,
+		"executionCost", executionCost,
+		"l1DataFee", l1DataFee,
+		"profitMargin", e.profitMargin,
+		"isProfitable", isProfitable,
+	)
+
+	return &Profitability{
+		IsProfitable:  isProfitable,
+		GasUsed:       gasUsed,
+		ExecutionCost: executionCost,
+		L1DataFee:     l1DataFee,
+		EstimatedCost: estimatedCost,
+	}, nil
+}
+
+// l1DataFee returns the L1 data fee of a transaction with the given calldata, or
+// zero if no GasPriceOracle is configured.
+func (e *DefaultProfitabilityEvaluator) l1DataFee(ctx context.Context, data []byte) (*big.Int, error) {
+	if e.l1FeeOracleAddress == relayer.ZeroAddress {
+		return big.NewInt(0), nil
+	}
+
+	callData, err := gasPriceOracleABI.Pack("getL1Fee", data)
+	if err != nil {
+		return nil, errors.Wrap(err, "gasPriceOracleABI.Pack")
+	}
+
+	result, err := e.client.CallContract(ctx, ethereum.CallMsg{
+		To:   &e.l1FeeOracleAddress,
+		Data: callData,
+	}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "e.client.CallContract")
+	}
+
+	out, err := gasPriceOracleABI.Unpack("getL1Fee", result)
+	if err != nil {
+		return nil, errors.Wrap(err, "gasPriceOracleABI.Unpack")
+	}
+
+	return abi.ConvertType(out[0], new(big.Int)).(*big.Int), nil
+}
@@ -0,0 +1,191 @@
+package processor
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+)
+
+var l1FeeOracleAddress = common.HexToAddress("0x420000000000000000000000000000000000000F")
+
+// profitabilityTestClient estimates a fixed amount of gas, and charges a fixed
+// L1 data fee.
+type profitabilityTestClient struct {
+	gasUsed   uint64
+	l1DataFee *big.Int
+}
+
+func (c *profitabilityTestClient) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	return c.gasUsed, nil
+}
+
+func (c *profitabilityTestClient) CallContract(
+	ctx context.Context,
+	msg ethereum.CallMsg,
+	blockNumber *big.Int,
+) ([]byte, error) {
+	if *msg.To != l1FeeOracleAddress {
+		return nil, ethereum.NotFound
+	}
+
+	return common.LeftPadBytes(c.l1DataFee.Bytes(), 32), nil
+}
+
+func Test_DefaultProfitabilityEvaluator_Evaluate(t *testing.T) {
+	tests := []struct {
+		name               string
+		fee                uint64
+		gasLimit           uint64
+		gasUsed            uint64
+		l1FeeOracleAddress common.Address
+		l1DataFee          *big.Int
+		profitMargin       uint64
+		wantProfitable     bool
+		wantEstimatedCost  *big.Int
+		wantErr            error
+	}{
+		{
+			"zeroProcessingFee",
+			0,
+			1,
+			1,
+			relayer.ZeroAddress,
+			big.NewInt(0),
+			0,
+			false,
+			nil,
+			errImpossible,
+		},
+		{
+			"zeroGasLimit",
+			1,
+			0,
+			1,
+			relayer.ZeroAddress,
+			big.NewInt(0),
+			0,
+			false,
+			nil,
+			errImpossible,
+		},
+		{
+			"profitable",
+			600000000600001,
+			700000,
+			600000,
+			relayer.ZeroAddress,
+			big.NewInt(0),
+			0,
+			true,
+			big.NewInt(600000000600000),
+			nil,
+		},
+		{
+			"unprofitable",
+			590000000600000,
+			700000,
+			600000,
+			relayer.ZeroAddress,
+			big.NewInt(0),
+			0,
+			false,
+			big.NewInt(600000000600000),
+			nil,
+		},
+		{
+			"gasUsedAboveGasLimit",
+			600000000600001,
+			500000,
+			600000,
+			relayer.ZeroAddress,
+			big.NewInt(0),
+			0,
+			false,
+			big.NewInt(600000000600000),
+			nil,
+		},
+		{
+			"unprofitableWithL1DataFee",
+			600000000600001,
+			700000,
+			600000,
+			l1FeeOracleAddress,
+			big.NewInt(1000),
+			0,
+			false,
+			big.NewInt(600000000601000),
+			nil,
+		},
+		{
+			"profitableWithL1DataFee",
+			600000000601001,
+			700000,
+			600000,
+			l1FeeOracleAddress,
+			big.NewInt(1000),
+			0,
+			true,
+			big.NewInt(600000000601000),
+			nil,
+		},
+		{
+			"unprofitableWithProfitMargin",
+			600000000600001,
+			700000,
+			600000,
+			relayer.ZeroAddress,
+			big.NewInt(0),
+			10,
+			false,
+			big.NewInt(600000000600000),
+			nil,
+		},
+		{
+			"profitableWithProfitMargin",
+			660000000660001,
+			700000,
+			600000,
+			relayer.ZeroAddress,
+			big.NewInt(0),
+			10,
+			true,
+			big.NewInt(600000000600000),
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewDefaultProfitabilityEvaluator(
+				&profitabilityTestClient{gasUsed: tt.gasUsed, l1DataFee: tt.l1DataFee},
+				tt.l1FeeOracleAddress,
+				tt.profitMargin,
+			)
+
+			profitability, err := e.Evaluate(context.Background(), EvaluateProfitabilityOpts{
+				Message:   bridge.IBridgeMessage{Fee: tt.fee},
+				GasLimit:  tt.gasLimit,
+				BaseFee:   big.NewInt(1000000000),
+				GasTipCap: big.NewInt(1),
+			})
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			assert.Equal(t, tt.wantProfitable, profitability.IsProfitable)
+			assert.Equal(t, tt.gasUsed, profitability.GasUsed)
+			assert.Equal(t, tt.l1DataFee, profitability.L1DataFee)
+			assert.Equal(t, tt.wantEstimatedCost, profitability.EstimatedCost)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package processor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+var (
+	ErrNoRoutes = errors.New("routes file must list at least one route")
+)
+
+// RouteHop is an intermediary chain of a Route, the same as a hopConfig.
+type RouteHop struct {
+	SignalServiceAddress common.Address `json:"signalServiceAddress"`
+	TaikoAddress         common.Address `json:"taikoAddress"`
+	RPCUrl               string         `json:"rpcUrl"`
+}
+
+// Route is one source chain to destination chain pair a MultiProcessor processes
+// messages for, with the chain specific settings a single processor takes as flags.
+type Route struct {
+	SrcRPCUrl                string         `json:"srcRpcUrl"`
+	DestRPCUrl               string         `json:"destRpcUrl"`
+	SrcSignalServiceAddress  common.Address `json:"srcSignalServiceAddress"`
+	DestBridgeAddress        common.Address `json:"destBridgeAddress"`
+	DestERC20VaultAddress    common.Address `json:"destERC20VaultAddress"`
+	DestERC721VaultAddress   common.Address `json:"destERC721VaultAddress"`
+	DestERC1155VaultAddress  common.Address `json:"destERC1155VaultAddress"`
+	DestTaikoAddress         common.Address `json:"destTaikoAddress"`
+	DestQuotaManagerAddress  common.Address `json:"destQuotaManagerAddress"`
+	DestSignalServiceAddress common.Address `json:"destSignalServiceAddress"`
+	L1FeeOracleAddress       common.Address `json:"l1FeeOracleAddress"`
+	// DestBatchProcessorAddress is only required when batching is enabled.
+	DestBatchProcessorAddress common.Address `json:"destBatchProcessorAddress"`
+	EnableTaikoL2             bool           `json:"enableTaikoL2"`
+	Hops                      []RouteHop     `json:"hops"`
+}
+
+// LoadRoutes reads and validates the JSON list of routes at path.
+func LoadRoutes(path string) ([]Route, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var routes []Route
+
+	if err := json.Unmarshal(data, &routes); err != nil {
+		return nil, fmt.Errorf("invalid routes file: %w", err)
+	}
+
+	if len(routes) == 0 {
+		return nil, ErrNoRoutes
+	}
+
+	for i, route := range routes {
+		if err := route.validate(); err != nil {
+			return nil, fmt.Errorf("invalid route %v: %w", i, err)
+		}
+	}
+
+	return routes, nil
+}
+
+func (r Route) validate() error {
+	if r.SrcRPCUrl == "" {
+		return errors.New("srcRpcUrl is required")
+	}
+
+	if r.DestRPCUrl == "" {
+		return errors.New("destRpcUrl is required")
+	}
+
+	if r.DestBridgeAddress == relayer.ZeroAddress {
+		return errors.New("destBridgeAddress is required")
+	}
+
+	if r.SrcSignalServiceAddress == relayer.ZeroAddress {
+		return errors.New("srcSignalServiceAddress is required")
+	}
+
+	for i, hop := range r.Hops {
+		if hop.RPCUrl == "" || hop.SignalServiceAddress == relayer.ZeroAddress {
+			return fmt.Errorf("hop %v requires rpcUrl and signalServiceAddress", i)
+		}
+	}
+
+	return nil
+}
+
+// config returns the processor config for this route, taking every setting which is
+// not chain specific from base.
+func (r Route) config(base *Config) *Config {
+	cfg := *base
+
+	cfg.SrcRPCUrl = r.SrcRPCUrl
+	cfg.DestRPCUrl = r.DestRPCUrl
+	cfg.SrcSignalServiceAddress = r.SrcSignalServiceAddress
+	cfg.DestBridgeAddress = r.DestBridgeAddress
+	cfg.DestERC20VaultAddress = r.DestERC20VaultAddress
+	cfg.DestERC721VaultAddress = r.DestERC721VaultAddress
+	cfg.DestERC1155VaultAddress = r.DestERC1155VaultAddress
+	cfg.DestTaikoAddress = r.DestTaikoAddress
+	cfg.DestQuotaManagerAddress = r.DestQuotaManagerAddress
+	cfg.DestSignalServiceAddress = r.DestSignalServiceAddress
+	cfg.L1FeeOracleAddress = r.L1FeeOracleAddress
+	cfg.DestBatchProcessorAddress = r.DestBatchProcessorAddress
+	cfg.EnableTaikoL2 = r.EnableTaikoL2
+
+	cfg.hopConfigs = []hopConfig{}
+	for _, hop := range r.Hops {
+		cfg.hopConfigs = append(cfg.hopConfigs, hopConfig{
+			signalServiceAddress: hop.SignalServiceAddress,
+			taikoAddress:         hop.TaikoAddress,
+			rpcURL:               hop.RPCUrl,
+		})
+	}
+
+	// transactions are sent to the destination chain of the route.
+	if base.TxmgrConfigs != nil {
+		txmgrConfigs := *base.TxmgrConfigs
+		txmgrConfigs.L1RPCURL = r.DestRPCUrl
+		cfg.TxmgrConfigs = &txmgrConfigs
+	}
+
+	return &cfg
+}
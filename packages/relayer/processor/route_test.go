@@ -0,0 +1,137 @@
+package processor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+var testRoutes = `[
+	{
+		"srcRpcUrl": "l1RpcUrl",
+		"destRpcUrl": "l2RpcUrl",
+		"srcSignalServiceAddress": "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD377",
+		"destBridgeAddress": "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD377",
+		"destERC20VaultAddress": "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD377",
+		"destTaikoAddress": "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD357",
+		"enableTaikoL2": true
+	},
+	{
+		"srcRpcUrl": "l2RpcUrl",
+		"destRpcUrl": "l3RpcUrl",
+		"srcSignalServiceAddress": "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD377",
+		"destBridgeAddress": "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD377",
+		"hops": [
+			{
+				"signalServiceAddress": "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD357",
+				"taikoAddress": "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD357",
+				"rpcUrl": "l1RpcUrl"
+			}
+		]
+	}
+]`
+
+func writeRoutesFile(t *testing.T, routes string) string {
+	path := filepath.Join(t.TempDir(), "routes.json")
+
+	assert.Nil(t, os.WriteFile(path, []byte(routes), 0o600))
+
+	return path
+}
+
+func Test_LoadRoutes(t *testing.T) {
+	routes, err := LoadRoutes(writeRoutesFile(t, testRoutes))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(routes))
+
+	assert.Equal(t, "l1RpcUrl", routes[0].SrcRPCUrl)
+	assert.Equal(t, "l2RpcUrl", routes[0].DestRPCUrl)
+	assert.Equal(t, common.HexToAddress(destBridgeAddr), routes[0].DestBridgeAddress)
+	assert.Equal(t, common.HexToAddress(destQuotaManagerAddr), routes[0].DestTaikoAddress)
+	assert.Equal(t, true, routes[0].EnableTaikoL2)
+	assert.Equal(t, 0, len(routes[0].Hops))
+
+	assert.Equal(t, 1, len(routes[1].Hops))
+	assert.Equal(t, "l1RpcUrl", routes[1].Hops[0].RPCUrl)
+}
+
+func Test_LoadRoutes_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		routes  string
+		wantErr string
+	}{
+		{
+			"invalidJSON",
+			`{`,
+			"invalid routes file",
+		},
+		{
+			"noRoutes",
+			`[]`,
+			ErrNoRoutes.Error(),
+		},
+		{
+			"noDestRpcUrl",
+			`[{"srcRpcUrl": "l1RpcUrl"}]`,
+			"invalid route 0: destRpcUrl is required",
+		},
+		{
+			"noDestBridgeAddress",
+			`[{"srcRpcUrl": "l1RpcUrl", "destRpcUrl": "l2RpcUrl"}]`,
+			"invalid route 0: destBridgeAddress is required",
+		},
+		{
+			"invalidHop",
+			`[{
+				"srcRpcUrl": "l1RpcUrl",
+				"destRpcUrl": "l2RpcUrl",
+				"srcSignalServiceAddress": "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD377",
+				"destBridgeAddress": "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD377",
+				"hops": [{"rpcUrl": "l3RpcUrl"}]
+			}]`,
+			"invalid route 0: hop 0 requires rpcUrl and signalServiceAddress",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadRoutes(writeRoutesFile(t, tt.routes))
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_Route_config(t *testing.T) {
+	routes, err := LoadRoutes(writeRoutesFile(t, testRoutes))
+	assert.Nil(t, err)
+
+	base := &Config{
+		SrcRPCUrl:         "unused",
+		Confirmations:     10,
+		MaxMessageRetries: 5,
+		TxmgrConfigs:      &txmgr.CLIConfig{L1RPCURL: "unused", NumConfirmations: 2},
+	}
+
+	cfg := routes[1].config(base)
+
+	assert.Equal(t, "l2RpcUrl", cfg.SrcRPCUrl)
+	assert.Equal(t, "l3RpcUrl", cfg.DestRPCUrl)
+	assert.Equal(t, uint64(10), cfg.Confirmations)
+	assert.Equal(t, uint64(5), cfg.MaxMessageRetries)
+	assert.Equal(t, []hopConfig{{
+		signalServiceAddress: common.HexToAddress(destQuotaManagerAddr),
+		taikoAddress:         common.HexToAddress(destQuotaManagerAddr),
+		rpcURL:               "l1RpcUrl",
+	}}, cfg.hopConfigs)
+
+	// transactions are sent to the destination chain of the route, without changing base.
+	assert.Equal(t, "l3RpcUrl", cfg.TxmgrConfigs.L1RPCURL)
+	assert.Equal(t, uint64(2), cfg.TxmgrConfigs.NumConfirmations)
+	assert.Equal(t, "unused", base.TxmgrConfigs.L1RPCURL)
+	assert.Equal(t, "unused", base.SrcRPCUrl)
+}
@@ -0,0 +1,266 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+)
+
+var (
+	ErrNoSponsorshipPolicies = errors.New("sponsorship policies file must list at least one policy")
+
+	eventTypeNames = map[string]relayer.EventType{
+		relayer.EventTypeSendETH.String():     relayer.EventTypeSendETH,
+		relayer.EventTypeSendERC20.String():   relayer.EventTypeSendERC20,
+		relayer.EventTypeSendERC721.String():  relayer.EventTypeSendERC721,
+		relayer.EventTypeSendERC1155.String(): relayer.EventTypeSendERC1155,
+	}
+)
+
+// sponsorship decisions, as recorded by the SponsorshipDecisions metric.
+const (
+	sponsorshipDecisionSponsored      = "sponsored"
+	sponsorshipDecisionBudgetExceeded = "budgetExceeded"
+	sponsorshipDecisionUnmatched      = "unmatched"
+)
+
+// SponsorshipPolicy selects messages the relayer processes at its own expense, even
+// when their fee is below minFeeToProcess or does not cover the cost of processing
+// them. A message matches a policy if it matches every list of the policy which is
+// not empty. The gas sponsored under a policy is limited per day, both in total and
+// per SrcOwner, unless the budget is 0.
+type SponsorshipPolicy struct {
+	Name      string           `json:"name"`
+	SrcOwners []common.Address `json:"srcOwners"`
+	// DestAddresses are matched against the address the message calls on the
+	// destination chain, which is the recipient for ETH, and the vault for tokens.
+	DestAddresses            []common.Address `json:"destAddresses"`
+	EventTypes               []string         `json:"eventTypes"`
+	CanonicalTokens          []common.Address `json:"canonicalTokens"`
+	DailyGasBudget           uint64           `json:"dailyGasBudget"`
+	DailyGasBudgetPerAddress uint64           `json:"dailyGasBudgetPerAddress"`
+}
+
+// LoadSponsorshipPolicies reads and validates the JSON list of policies at path.
+func LoadSponsorshipPolicies(path string) ([]SponsorshipPolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []SponsorshipPolicy
+
+	if err := json.Unmarshal(data, &policies); err != nil {
+		return nil, fmt.Errorf("invalid sponsorship policies file: %w", err)
+	}
+
+	if len(policies) == 0 {
+		return nil, ErrNoSponsorshipPolicies
+	}
+
+	names := make(map[string]bool, len(policies))
+
+	for i, policy := range policies {
+		if err := policy.validate(); err != nil {
+			return nil, fmt.Errorf("invalid sponsorship policy %v: %w", i, err)
+		}
+
+		if names[policy.Name] {
+			return nil, fmt.Errorf("invalid sponsorship policy %v: name %v is not unique", i, policy.Name)
+		}
+
+		names[policy.Name] = true
+	}
+
+	return policies, nil
+}
+
+func (s SponsorshipPolicy) validate() error {
+	if s.Name == "" {
+		return errors.New("name is required")
+	}
+
+	if len(s.SrcOwners) == 0 && len(s.DestAddresses) == 0 &&
+		len(s.EventTypes) == 0 && len(s.CanonicalTokens) == 0 {
+		return errors.New("at least one of srcOwners, destAddresses, eventTypes and canonicalTokens is required")
+	}
+
+	for _, eventType := range s.EventTypes {
+		if _, ok := eventTypeNames[eventType]; !ok {
+			return fmt.Errorf("unknown eventType %v", eventType)
+		}
+	}
+
+	return nil
+}
+
+// matches returns whether a message, of eventType and bridging canonicalToken,
+// matches the policy.
+func (s SponsorshipPolicy) matches(
+	message bridge.IBridgeMessage,
+	eventType relayer.EventType,
+	canonicalToken common.Address,
+) bool {
+	if len(s.SrcOwners) > 0 && !slices.Contains(s.SrcOwners, message.SrcOwner) {
+		return false
+	}
+
+	if len(s.DestAddresses) > 0 && !slices.Contains(s.DestAddresses, message.To) {
+		return false
+	}
+
+	if len(s.EventTypes) > 0 && !slices.Contains(s.EventTypes, eventType.String()) {
+		return false
+	}
+
+	if len(s.CanonicalTokens) > 0 && !slices.Contains(s.CanonicalTokens, canonicalToken) {
+		return false
+	}
+
+	return true
+}
+
+// sponsoringPolicy returns the first policy which matches the message and has the
+// gas budget left to process it today, or nil if there is none. The message is only
+// counted as sponsored once it has been processed, by recordSponsoredGas.
+func (p *Processor) sponsoringPolicy(
+	ctx context.Context,
+	event *bridge.BridgeMessageSent,
+) (*SponsorshipPolicy, error) {
+	if len(p.sponsorshipPolicies) == 0 {
+		return nil, nil
+	}
+
+	eventType, canonicalToken, _, err := relayer.DecodeMessageData(event.Message.Data, event.Message.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	// ETH is bridged when there is no canonical token.
+	var tokenAddress common.Address = zeroAddress
+	if canonicalToken != nil {
+		tokenAddress = canonicalToken.Address()
+	}
+
+	// the gas limit processMessage is sent with, which bounds the gas it uses.
+	gasLimit := uint64(float64(event.Message.GasLimit) * 1.05)
+
+	day := time.Now().UTC().Format(time.DateOnly)
+
+	srcOwner := event.Message.SrcOwner.Hex()
+
+	for i, policy := range p.sponsorshipPolicies {
+		if !policy.matches(event.Message, eventType, tokenAddress) {
+			continue
+		}
+
+		withinBudget, err := p.withinSponsorshipBudget(ctx, policy, day, srcOwner, gasLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		if !withinBudget {
+			slog.Info("sponsorship budget exceeded",
+				"policy", policy.Name,
+				"srcOwner", srcOwner,
+				"srcTxHash", event.Raw.TxHash.Hex(),
+			)
+
+			relayer.SponsorshipDecisions.WithLabelValues(policy.Name, sponsorshipDecisionBudgetExceeded).Inc()
+
+			continue
+		}
+
+		return &p.sponsorshipPolicies[i], nil
+	}
+
+	relayer.SponsorshipDecisions.WithLabelValues("", sponsorshipDecisionUnmatched).Inc()
+
+	return nil, nil
+}
+
+// withinSponsorshipBudget returns whether gasLimit more gas can be sponsored for
+// srcOwner under policy on day. Messages being processed at the same time are all
+// checked against the budget left before them, so it can be exceeded by a few messages.
+func (p *Processor) withinSponsorshipBudget(
+	ctx context.Context,
+	policy SponsorshipPolicy,
+	day string,
+	srcOwner string,
+	gasLimit uint64,
+) (bool, error) {
+	if policy.DailyGasBudget != 0 {
+		gasUsed, err := p.sponsoredGasUsageRepo.GasUsed(ctx, relayer.FindSponsoredGasUsageOpts{
+			Policy: policy.Name,
+			Day:    day,
+		})
+		if err != nil {
+			return false, err
+		}
+
+		if gasUsed+gasLimit > policy.DailyGasBudget {
+			return false, nil
+		}
+	}
+
+	if policy.DailyGasBudgetPerAddress != 0 {
+		gasUsed, err := p.sponsoredGasUsageRepo.GasUsed(ctx, relayer.FindSponsoredGasUsageOpts{
+			Policy:  policy.Name,
+			Address: &srcOwner,
+			Day:     day,
+		})
+		if err != nil {
+			return false, err
+		}
+
+		if gasUsed+gasLimit > policy.DailyGasBudgetPerAddress {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// recordSponsoredGas counts a sponsored message, and the gas used processing it against
+// the budgets of policy. The message has been processed already, so failing to record it
+// is only logged.
+func (p *Processor) recordSponsoredGas(
+	ctx context.Context,
+	policy *SponsorshipPolicy,
+	event *bridge.BridgeMessageSent,
+	gasUsed uint64,
+) {
+	slog.Info("sponsored message",
+		"policy", policy.Name,
+		"srcOwner", event.Message.SrcOwner.Hex(),
+		"srcTxHash", event.Raw.TxHash.Hex(),
+		"gasUsed", gasUsed,
+	)
+
+	relayer.SponsorshipDecisions.WithLabelValues(policy.Name, sponsorshipDecisionSponsored).Inc()
+
+	relayer.SponsoredGasUsed.WithLabelValues(policy.Name).Add(float64(gasUsed))
+
+	if err := p.sponsoredGasUsageRepo.Add(ctx, relayer.AddSponsoredGasUsageOpts{
+		Policy:  policy.Name,
+		Address: event.Message.SrcOwner.Hex(),
+		Day:     time.Now().UTC().Format(time.DateOnly),
+		GasUsed: gasUsed,
+	}); err != nil {
+		slog.Error("failed to record sponsored gas",
+			"policy", policy.Name,
+			"srcTxHash", event.Raw.TxHash.Hex(),
+			"error", err,
+		)
+	}
+}
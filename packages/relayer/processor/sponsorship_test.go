@@ -0,0 +1,158 @@
+package processor
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+)
+
+var (
+	sponsoredOwner = common.HexToAddress("0xC4279588B8dA563D264e286E2ee7CE8c244444d6")
+	otherOwner     = common.HexToAddress("0x63FaC9201494f0bd17B9892B9fae4d52fe3BD377")
+)
+
+var testSponsorshipPolicies = `[
+	{
+		"name": "partner",
+		"srcOwners": ["0xC4279588B8dA563D264e286E2ee7CE8c244444d6"],
+		"eventTypes": ["sendETH"],
+		"dailyGasBudget": 1000000,
+		"dailyGasBudgetPerAddress": 200000
+	},
+	{
+		"name": "token",
+		"canonicalTokens": ["0x63FaC9201494f0bd17B9892B9fae4d52fe3BD357"]
+	}
+]`
+
+func Test_LoadSponsorshipPolicies(t *testing.T) {
+	policies, err := LoadSponsorshipPolicies(writeRoutesFile(t, testSponsorshipPolicies))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(policies))
+
+	assert.Equal(t, "partner", policies[0].Name)
+	assert.Equal(t, []common.Address{sponsoredOwner}, policies[0].SrcOwners)
+	assert.Equal(t, []string{"sendETH"}, policies[0].EventTypes)
+	assert.Equal(t, uint64(1000000), policies[0].DailyGasBudget)
+	assert.Equal(t, uint64(200000), policies[0].DailyGasBudgetPerAddress)
+
+	assert.Equal(t, []common.Address{common.HexToAddress(destQuotaManagerAddr)}, policies[1].CanonicalTokens)
+}
+
+func Test_LoadSponsorshipPolicies_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		policies string
+		wantErr  string
+	}{
+		{
+			"noPolicies",
+			`[]`,
+			ErrNoSponsorshipPolicies.Error(),
+		},
+		{
+			"noName",
+			`[{"eventTypes": ["sendETH"]}]`,
+			"name is required",
+		},
+		{
+			"noMatchers",
+			`[{"name": "all"}]`,
+			"at least one of",
+		},
+		{
+			"unknownEventType",
+			`[{"name": "nft", "eventTypes": ["sendNFT"]}]`,
+			"unknown eventType sendNFT",
+		},
+		{
+			"duplicateName",
+			`[{"name": "eth", "eventTypes": ["sendETH"]}, {"name": "eth", "eventTypes": ["sendERC20"]}]`,
+			"name eth is not unique",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadSponsorshipPolicies(writeRoutesFile(t, tt.policies))
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func testSponsoredEvent(srcOwner common.Address, gasLimit uint32) *bridge.BridgeMessageSent {
+	return &bridge.BridgeMessageSent{
+		Message: bridge.IBridgeMessage{
+			SrcOwner: srcOwner,
+			GasLimit: gasLimit,
+			Value:    big.NewInt(1),
+			Data:     []byte{},
+		},
+	}
+}
+
+func Test_sponsoringPolicy(t *testing.T) {
+	p := newTestProcessor(true)
+
+	policies, err := LoadSponsorshipPolicies(writeRoutesFile(t, testSponsorshipPolicies))
+	assert.Nil(t, err)
+
+	p.sponsorshipPolicies = policies
+
+	// the message matches the srcOwner and eventType of the first policy.
+	sponsor, err := p.sponsoringPolicy(context.Background(), testSponsoredEvent(sponsoredOwner, 100000))
+	assert.Nil(t, err)
+	assert.Equal(t, "partner", sponsor.Name)
+
+	// other owners do not match any policy.
+	sponsor, err = p.sponsoringPolicy(context.Background(), testSponsoredEvent(otherOwner, 100000))
+	assert.Nil(t, err)
+	assert.Nil(t, sponsor)
+
+	p.recordSponsoredGas(context.Background(), &policies[0], testSponsoredEvent(sponsoredOwner, 100000), 100000)
+
+	// the owner has 100000 gas of its daily budget left, less than the padded gas limit.
+	sponsor, err = p.sponsoringPolicy(context.Background(), testSponsoredEvent(sponsoredOwner, 100000))
+	assert.Nil(t, err)
+	assert.Nil(t, sponsor)
+
+	sponsor, err = p.sponsoringPolicy(context.Background(), testSponsoredEvent(sponsoredOwner, 50000))
+	assert.Nil(t, err)
+	assert.Equal(t, "partner", sponsor.Name)
+}
+
+func Test_sponsoringPolicy_countedOnceProcessed(t *testing.T) {
+	p := newTestProcessor(true)
+
+	policies, err := LoadSponsorshipPolicies(writeRoutesFile(t, testSponsorshipPolicies))
+	assert.Nil(t, err)
+
+	p.sponsorshipPolicies = policies
+
+	sponsored := relayer.SponsorshipDecisions.WithLabelValues("partner", sponsorshipDecisionSponsored)
+	before := testutil.ToFloat64(sponsored)
+
+	// choosing the policy does not count the message as sponsored yet, it may fail to be processed.
+	sponsor, err := p.sponsoringPolicy(context.Background(), testSponsoredEvent(sponsoredOwner, 100000))
+	assert.Nil(t, err)
+	assert.Equal(t, "partner", sponsor.Name)
+	assert.Equal(t, before, testutil.ToFloat64(sponsored))
+
+	p.recordSponsoredGas(context.Background(), sponsor, testSponsoredEvent(sponsoredOwner, 100000), 100000)
+	assert.Equal(t, before+1, testutil.ToFloat64(sponsored))
+}
+
+func Test_sponsoringPolicy_noPolicies(t *testing.T) {
+	p := newTestProcessor(true)
+
+	sponsor, err := p.sponsoringPolicy(context.Background(), testSponsoredEvent(sponsoredOwner, 100000))
+	assert.Nil(t, err)
+	assert.Nil(t, sponsor)
+}
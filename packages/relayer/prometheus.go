@@ -54,6 +54,18 @@ var (
 		Name: "message_sent_events_processed_reverted_ops_total",
 		Help: "The total number of MessageSent processed events that reverted",
 	})
+	ProcessMessageBatchesSent = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "process_message_batches_sent_ops_total",
+		Help: "The total number of transactions sent processing more than one message",
+	})
+	SponsorshipDecisions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "sponsorship_decisions_ops_total",
+		Help: "The total number of sponsorship decisions made, by policy and decision",
+	}, []string{"policy", "decision"})
+	SponsoredGasUsed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "sponsored_gas_used_total",
+		Help: "The total gas used processing sponsored messages, by policy",
+	}, []string{"policy"})
 	MessageSentEventsIndexed = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "message_sent_events_indexed_ops_total",
 		Help: "The total number of MessageSent indexed events",
@@ -106,6 +118,14 @@ var (
 		Name: "bridge_paused_errors_ops_total",
 		Help: "The total number of times the bridge has encountered an error while attempting to have been paused",
 	})
+	MessagesSuspended = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "messages_suspended_ops_total",
+		Help: "The total number of times an individual bridge message has been suspended",
+	})
+	SuspendedMessagesSkipped = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "suspended_messages_skipped_ops_total",
+		Help: "The total number of times the processor skipped a message because it was suspended",
+	})
 	RetriableEvents = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "events_processed_retriable_status_ops_total",
 		Help: "The total number of processed events that ended up in Retriable status",
@@ -146,6 +166,10 @@ var (
 		Name: "message_processed_events_after_retry_error_count",
 		Help: "The total number of errors logged for MessageProcessed events after retries",
 	})
+	ReorgsDetected = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "reorgs_detected",
+		Help: "The total number of reorgs the indexer has detected and rolled back",
+	})
 	RelayerKeyBalanceGauge = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "relayer_key_balance",
 		Help: "Current balance of the relayer key",
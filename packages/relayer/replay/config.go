@@ -0,0 +1,142 @@
+package replay
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/backend"
+	"github.com/urfave/cli/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// Config is a struct which should be created from the cli or environment variables, populated,
+// and used to create a new Replay.
+type Config struct {
+	// db configs
+	DatabaseDialect         string
+	DatabaseUsername        string
+	DatabasePassword        string
+	DatabaseName            string
+	DatabaseHost            string
+	DatabaseMaxIdleConns    uint64
+	DatabaseMaxOpenConns    uint64
+	DatabaseMaxConnLifetime uint64
+	// queue configs
+	QueueType     string
+	QueueDataDir  string
+	QueueUsername string
+	QueuePassword string
+	QueueHost     string
+	QueuePort     uint64
+	// replay filters, at least one of which must be set
+	MsgHash       *string
+	SrcChainID    *int64
+	Since         *time.Time
+	Until         *time.Time
+	OpenQueueFunc func() (queue.Queue, error)
+	OpenDBFunc    func() (db.DB, error)
+}
+
+// NewConfigFromCliContext creates a new config instance from command line flags.
+func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
+	cfg := &Config{
+		DatabaseDialect:         c.String(flags.DatabaseDialect.Name),
+		DatabaseUsername:        c.String(flags.DatabaseUsername.Name),
+		DatabasePassword:        c.String(flags.DatabasePassword.Name),
+		DatabaseName:            c.String(flags.DatabaseName.Name),
+		DatabaseHost:            c.String(flags.DatabaseHost.Name),
+		DatabaseMaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
+		DatabaseMaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
+		DatabaseMaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
+		QueueType:               c.String(flags.QueueType.Name),
+		QueueDataDir:            c.String(flags.QueueDataDir.Name),
+		QueueUsername:           c.String(flags.QueueUsername.Name),
+		QueuePassword:           c.String(flags.QueuePassword.Name),
+		QueuePort:               c.Uint64(flags.QueuePort.Name),
+		QueueHost:               c.String(flags.QueueHost.Name),
+		OpenDBFunc: func() (db.DB, error) {
+			return db.OpenDBConnection(db.DBConnectionOpts{
+				Dialect:         db.Dialect(c.String(flags.DatabaseDialect.Name)),
+				Name:            c.String(flags.DatabaseUsername.Name),
+				Password:        c.String(flags.DatabasePassword.Name),
+				Database:        c.String(flags.DatabaseName.Name),
+				Host:            c.String(flags.DatabaseHost.Name),
+				MaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
+				MaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
+				MaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
+				OpenFunc: func(dialector gorm.Dialector) (db.DB, error) {
+					gormDB, err := gorm.Open(dialector, &gorm.Config{
+						Logger: logger.Default.LogMode(logger.Silent),
+					})
+					if err != nil {
+						return nil, err
+					}
+
+					return db.New(gormDB), nil
+				},
+			})
+		},
+		OpenQueueFunc: func() (queue.Queue, error) {
+			opts := queue.NewQueueOpts{
+				Username: c.String(flags.QueueUsername.Name),
+				Password: c.String(flags.QueuePassword.Name),
+				Host:     c.String(flags.QueueHost.Name),
+				Port:     c.String(flags.QueuePort.Name),
+				DataDir:  c.String(flags.QueueDataDir.Name),
+			}
+
+			q, err := backend.Open(queue.Type(c.String(flags.QueueType.Name)), opts)
+			if err != nil {
+				return nil, err
+			}
+
+			return q, nil
+		},
+	}
+
+	if c.IsSet(flags.ReplayMsgHash.Name) {
+		msgHash := c.String(flags.ReplayMsgHash.Name)
+		cfg.MsgHash = &msgHash
+	}
+
+	if c.IsSet(flags.ReplaySrcChainID.Name) {
+		srcChainID := int64(c.Uint64(flags.ReplaySrcChainID.Name))
+		cfg.SrcChainID = &srcChainID
+	}
+
+	if c.IsSet(flags.ReplaySince.Name) {
+		since, err := time.Parse(time.RFC3339, c.String(flags.ReplaySince.Name))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %v: %w", flags.ReplaySince.Name, err)
+		}
+
+		cfg.Since = &since
+	}
+
+	if c.IsSet(flags.ReplayUntil.Name) {
+		until, err := time.Parse(time.RFC3339, c.String(flags.ReplayUntil.Name))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %v: %w", flags.ReplayUntil.Name, err)
+		}
+
+		cfg.Until = &until
+	}
+
+	// replaying every dead-lettered message at once is rarely intended, so require
+	// the caller to narrow it down.
+	if cfg.MsgHash == nil && cfg.SrcChainID == nil && cfg.Since == nil && cfg.Until == nil {
+		return nil, fmt.Errorf(
+			"at least one of %v, %v, %v or %v is required",
+			flags.ReplayMsgHash.Name,
+			flags.ReplaySrcChainID.Name,
+			flags.ReplaySince.Name,
+			flags.ReplayUntil.Name,
+		)
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,89 @@
+package replay
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
+)
+
+func setupApp() *cli.App {
+	app := cli.NewApp()
+	app.Flags = flags.ReplayFlags
+	app.Action = func(ctx *cli.Context) error {
+		_, err := NewConfigFromCliContext(ctx)
+		return err
+	}
+
+	return app
+}
+
+var requiredArgs = []string{
+	"TestNewConfigFromCliContext",
+	"--" + flags.DatabaseUsername.Name, "dbuser",
+	"--" + flags.DatabasePassword.Name, "dbpass",
+	"--" + flags.DatabaseHost.Name, "dbhost",
+	"--" + flags.DatabaseName.Name, "dbname",
+	"--" + flags.QueueType.Name, "inprocess",
+	"--" + flags.QueueDataDir.Name, "queuedatadir",
+}
+
+func TestNewConfigFromCliContext(t *testing.T) {
+	app := setupApp()
+
+	app.Action = func(ctx *cli.Context) error {
+		c, err := NewConfigFromCliContext(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, "dbuser", c.DatabaseUsername)
+		assert.Equal(t, "dbpass", c.DatabasePassword)
+		assert.Equal(t, "dbname", c.DatabaseName)
+		assert.Equal(t, "dbhost", c.DatabaseHost)
+		assert.Equal(t, "inprocess", c.QueueType)
+		assert.Equal(t, "queuedatadir", c.QueueDataDir)
+		assert.Equal(t, "0x1234", *c.MsgHash)
+		assert.Equal(t, int64(167001), *c.SrcChainID)
+		assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), *c.Since)
+		assert.Equal(t, time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), *c.Until)
+
+		return err
+	}
+
+	assert.Nil(t, app.Run(append(requiredArgs,
+		"--"+flags.ReplayMsgHash.Name, "0x1234",
+		"--"+flags.ReplaySrcChainID.Name, "167001",
+		"--"+flags.ReplaySince.Name, "2024-03-01T00:00:00Z",
+		"--"+flags.ReplayUntil.Name, "2024-03-02T00:00:00Z",
+	)))
+}
+
+func TestNewConfigFromCliContext_DatabaseDialect(t *testing.T) {
+	app := setupApp()
+
+	app.Action = func(ctx *cli.Context) error {
+		c, err := NewConfigFromCliContext(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, "postgres", c.DatabaseDialect)
+
+		return err
+	}
+
+	assert.Nil(t, app.Run(append(requiredArgs,
+		"--"+flags.DatabaseDialect.Name, "postgres",
+		"--"+flags.ReplayMsgHash.Name, "0x1234",
+	)))
+}
+
+func TestNewConfigFromCliContext_NoFilter(t *testing.T) {
+	app := setupApp()
+	assert.ErrorContains(t, app.Run(requiredArgs), "at least one of")
+}
+
+func TestNewConfigFromCliContext_InvalidTime(t *testing.T) {
+	app := setupApp()
+	assert.ErrorContains(t, app.Run(append(requiredArgs,
+		"--"+flags.ReplaySince.Name, "yesterday",
+	)), "invalid replay.since")
+}
@@ -0,0 +1,133 @@
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/repo"
+)
+
+// Replay puts messages the processor dead-lettered after reaching maxMessageRetries
+// back onto the processing queue of the route they were sent on, with their retry
+// count reset.
+type Replay struct {
+	ctx context.Context
+
+	deadLetterRepo relayer.DeadLetterMessageRepository
+
+	queue queue.Queue
+
+	opts relayer.FindDeadLetterMessagesOpts
+}
+
+func (r *Replay) InitFromCli(ctx context.Context, c *cli.Context) error {
+	cfg, err := NewConfigFromCliContext(c)
+	if err != nil {
+		return err
+	}
+
+	return InitFromConfig(ctx, r, cfg)
+}
+
+func InitFromConfig(ctx context.Context, r *Replay, cfg *Config) error {
+	db, err := cfg.OpenDBFunc()
+	if err != nil {
+		return err
+	}
+
+	deadLetterRepository, err := repo.NewDeadLetterMessageRepository(db)
+	if err != nil {
+		return err
+	}
+
+	q, err := cfg.OpenQueueFunc()
+	if err != nil {
+		return err
+	}
+
+	r.ctx = ctx
+	r.deadLetterRepo = deadLetterRepository
+	r.queue = q
+	r.opts = relayer.FindDeadLetterMessagesOpts{
+		MsgHash:    cfg.MsgHash,
+		SrcChainID: cfg.SrcChainID,
+		Since:      cfg.Since,
+		Until:      cfg.Until,
+	}
+
+	return nil
+}
+
+func (r *Replay) Name() string {
+	return "replay"
+}
+
+func (r *Replay) Close(ctx context.Context) {
+	r.queue.Close(ctx)
+}
+
+// Start replays every matching dead-lettered message, and returns once done.
+func (r *Replay) Start() error {
+	msgs, err := r.deadLetterRepo.Find(r.ctx, r.opts)
+	if err != nil {
+		return errors.Wrap(err, "r.deadLetterRepo.Find")
+	}
+
+	slog.Info("replaying dead-lettered messages", "count", len(msgs))
+
+	for _, m := range msgs {
+		if err := r.replay(r.ctx, m); err != nil {
+			return errors.Wrapf(err, "replaying dead-lettered message %v", m.ID)
+		}
+	}
+
+	slog.Info("replayed dead-lettered messages", "count", len(msgs))
+
+	return nil
+}
+
+func (r *Replay) replay(ctx context.Context, m *relayer.DeadLetterMessage) error {
+	msgBody := &queue.QueueMessageSentBody{}
+	if err := json.Unmarshal(m.Body, msgBody); err != nil {
+		return errors.Wrap(err, "json.Unmarshal")
+	}
+
+	// give the message a fresh set of retries.
+	msgBody.TimesRetried = 0
+	msgBody.LastError = ""
+
+	body, err := json.Marshal(msgBody)
+	if err != nil {
+		return errors.Wrap(err, "json.Marshal")
+	}
+
+	if err := r.queue.Publish(
+		ctx,
+		queue.Name(big.NewInt(m.SrcChainID), big.NewInt(m.DestChainID), relayer.EventNameMessageSent),
+		body,
+		nil,
+		nil,
+	); err != nil {
+		return errors.Wrap(err, "r.queue.Publish")
+	}
+
+	if err := r.deadLetterRepo.MarkReplayed(ctx, m.ID); err != nil {
+		return errors.Wrap(err, "r.deadLetterRepo.MarkReplayed")
+	}
+
+	slog.Info("replayed dead-lettered message",
+		"msgHash", m.MsgHash,
+		"srcChainID", m.SrcChainID,
+		"destChainID", m.DestChainID,
+		"lastError", m.LastError,
+	)
+
+	return nil
+}
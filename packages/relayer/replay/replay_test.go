@@ -0,0 +1,84 @@
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/inprocess"
+)
+
+func Test_Start(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	q, err := inprocess.NewQueue(queue.NewQueueOpts{DataDir: t.TempDir()})
+	assert.Nil(t, err)
+
+	defer q.Close(ctx)
+
+	deadLetterRepo := mock.NewDeadLetterMessageRepository()
+
+	for _, opts := range []relayer.SaveDeadLetterMessageOpts{
+		{MessageID: 1, SrcChainID: 1, DestChainID: 2, MsgHash: "0x1"},
+		{MessageID: 2, SrcChainID: 2, DestChainID: 1, MsgHash: "0x2"},
+	} {
+		body, err := json.Marshal(queue.QueueMessageSentBody{
+			ID:           opts.MessageID,
+			TimesRetried: 5,
+			LastError:    "execution reverted",
+		})
+		assert.Nil(t, err)
+
+		opts.Body = body
+
+		_, err = deadLetterRepo.Save(ctx, opts)
+		assert.Nil(t, err)
+	}
+
+	srcChainID := int64(1)
+
+	r := &Replay{
+		ctx:            ctx,
+		deadLetterRepo: deadLetterRepo,
+		queue:          q,
+		opts: relayer.FindDeadLetterMessagesOpts{
+			SrcChainID: &srcChainID,
+		},
+	}
+
+	assert.Nil(t, r.Start())
+
+	// only the message from chain 1 is left to replay.
+	remaining, err := deadLetterRepo.Find(ctx, relayer.FindDeadLetterMessagesOpts{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(remaining))
+	assert.Equal(t, int64(2), remaining[0].SrcChainID)
+
+	assert.Nil(t, q.Start(ctx, queue.Name(big.NewInt(1), big.NewInt(2), relayer.EventNameMessageSent)))
+
+	msgs := make(chan queue.Message)
+
+	go func() {
+		_ = q.Subscribe(ctx, msgs, &sync.WaitGroup{})
+	}()
+
+	select {
+	case m := <-msgs:
+		msgBody := &queue.QueueMessageSentBody{}
+		assert.Nil(t, json.Unmarshal(m.Body, msgBody))
+		assert.Equal(t, 1, msgBody.ID)
+		assert.Equal(t, uint64(0), msgBody.TimesRetried)
+		assert.Equal(t, "", msgBody.LastError)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for replayed message")
+	}
+}
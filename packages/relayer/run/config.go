@@ -0,0 +1,40 @@
+package run
+
+import (
+	"github.com/taikoxyz/taiko-mono/packages/relayer/api"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/indexer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/processor"
+	"github.com/urfave/cli/v2"
+)
+
+// Config holds the configs of every component Run starts, all read from the same
+// command line flags.
+type Config struct {
+	Indexer   *indexer.Config
+	Processor *processor.Config
+	API       *api.Config
+}
+
+// NewConfigFromCliContext creates a new config instance from command line flags.
+func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
+	indexerCfg, err := indexer.NewConfigFromCliContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	processorCfg, err := processor.NewConfigFromCliContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	apiCfg, err := api.NewConfigFromCliContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Config{
+		Indexer:   indexerCfg,
+		Processor: processorCfg,
+		API:       apiCfg,
+	}, nil
+}
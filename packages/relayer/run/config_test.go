@@ -0,0 +1,70 @@
+package run
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
+)
+
+var (
+	dummyEcdsaKey  = "8da4ef21b864d2cc526dbdb2a120bd2874c36c9d0a1fb7f8c63d7f7a8b41de8f"
+	srcTaikoAddr   = "0x53FaC9201494f0bd17B9892B9fae4d52fe3BD377"
+	srcBridgeAddr  = "0x73FaC9201494f0bd17B9892B9fae4d52fe3BD377"
+	destBridgeAddr = "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD377"
+)
+
+func TestNewConfigFromCliContext(t *testing.T) {
+	app := cli.NewApp()
+	app.Flags = flags.RunFlags
+
+	app.Action = func(ctx *cli.Context) error {
+		c, err := NewConfigFromCliContext(ctx)
+		assert.Nil(t, err)
+
+		// every component reads the shared flags.
+		assert.Equal(t, "dbuser", c.Indexer.DatabaseUsername)
+		assert.Equal(t, "dbuser", c.Processor.DatabaseUsername)
+		assert.Equal(t, "dbuser", c.API.DatabaseUsername)
+		assert.Equal(t, "srcRpcUrl", c.Indexer.SrcRPCUrl)
+		assert.Equal(t, "srcRpcUrl", c.Processor.SrcRPCUrl)
+		assert.Equal(t, "srcRpcUrl", c.API.SrcRPCUrl)
+		assert.Equal(t, "inprocess", c.Indexer.QueueType)
+		assert.Equal(t, "inprocess", c.Processor.QueueType)
+
+		// as well as their own.
+		assert.Equal(t, common.HexToAddress(srcBridgeAddr), c.Indexer.SrcBridgeAddress)
+		assert.Equal(t, relayer.EventNameMessageSent, c.Indexer.EventName)
+		assert.Equal(t, common.HexToAddress(destBridgeAddr), c.Processor.DestBridgeAddress)
+		assert.Equal(t, uint64(4102), c.API.HTTPPort)
+
+		return err
+	}
+
+	assert.Nil(t, app.Run([]string{
+		"TestNewConfigFromCliContext",
+		"--" + flags.DatabaseUsername.Name, "dbuser",
+		"--" + flags.DatabasePassword.Name, "dbpass",
+		"--" + flags.DatabaseHost.Name, "dbhost",
+		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.QueueType.Name, "inprocess",
+		"--" + flags.QueueDataDir.Name, "queuedatadir",
+		"--" + flags.SrcRPCUrl.Name, "srcRpcUrl",
+		"--" + flags.DestRPCUrl.Name, "destRpcUrl",
+		"--" + flags.SrcBridgeAddress.Name, srcBridgeAddr,
+		"--" + flags.SrcTaikoAddress.Name, srcTaikoAddr,
+		"--" + flags.DestBridgeAddress.Name, destBridgeAddr,
+		"--" + flags.SrcSignalServiceAddress.Name, destBridgeAddr,
+		"--" + flags.DestERC721VaultAddress.Name, destBridgeAddr,
+		"--" + flags.DestERC20VaultAddress.Name, destBridgeAddr,
+		"--" + flags.DestERC1155VaultAddress.Name, destBridgeAddr,
+		"--" + flags.DestTaikoAddress.Name, destBridgeAddr,
+		"--" + flags.ProcessorPrivateKey.Name, dummyEcdsaKey,
+		"--" + flags.EventName.Name, relayer.EventNameMessageSent,
+		"--" + flags.HTTPPort.Name, "4102",
+	}))
+}
@@ -0,0 +1,150 @@
+package run
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/api"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/utils"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/indexer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/processor"
+)
+
+// Run starts the indexer, processor and API for one chain pair in a single process.
+// They share one DB pool and one RPC connection per URL, and are shut down together:
+// each component is closed in the reverse order it was started in, and the shared
+// connections are closed only once all of them have stopped.
+type Run struct {
+	db db.DB
+
+	rpcClientsMu sync.Mutex
+	rpcClients   map[string]*rpc.Client
+	dialFunc     func(url string) (*rpc.Client, error)
+
+	components []utils.SubcommandApplication
+}
+
+func (r *Run) InitFromCli(ctx context.Context, c *cli.Context) error {
+	cfg, err := NewConfigFromCliContext(c)
+	if err != nil {
+		return err
+	}
+
+	return InitFromConfig(ctx, r, cfg)
+}
+
+func InitFromConfig(ctx context.Context, r *Run, cfg *Config) (err error) {
+	database, err := cfg.Indexer.OpenDBFunc()
+	if err != nil {
+		return err
+	}
+
+	r.db = database
+	r.rpcClients = make(map[string]*rpc.Client)
+	r.dialFunc = cfg.Indexer.DialRPCClientFunc
+
+	defer func() {
+		if err != nil {
+			r.closeShared()
+		}
+	}()
+
+	sharedDB := db.NewShared(database)
+
+	openDB := func() (db.DB, error) {
+		return sharedDB, nil
+	}
+
+	cfg.Indexer.OpenDBFunc = openDB
+	cfg.Indexer.DialRPCClientFunc = r.dialRPCClient
+
+	cfg.Processor.OpenDBFunc = openDB
+	cfg.Processor.DialRPCClientFunc = r.dialRPCClient
+
+	cfg.API.OpenDBFunc = openDB
+	cfg.API.DialRPCClientFunc = r.dialRPCClient
+
+	i := new(indexer.Indexer)
+	if err := indexer.InitFromConfig(ctx, i, cfg.Indexer); err != nil {
+		return err
+	}
+
+	p := new(processor.Processor)
+	if err := processor.InitFromConfig(ctx, p, cfg.Processor); err != nil {
+		return err
+	}
+
+	a := new(api.API)
+	if err := api.InitFromConfig(ctx, a, cfg.API); err != nil {
+		return err
+	}
+
+	r.components = []utils.SubcommandApplication{i, p, a}
+
+	return nil
+}
+
+// dialRPCClient returns the RPC client for url, dialing it the first time it is requested.
+func (r *Run) dialRPCClient(url string) (*rpc.Client, error) {
+	r.rpcClientsMu.Lock()
+	defer r.rpcClientsMu.Unlock()
+
+	if client, ok := r.rpcClients[url]; ok {
+		return client, nil
+	}
+
+	client, err := r.dialFunc(url)
+	if err != nil {
+		return nil, err
+	}
+
+	r.rpcClients[url] = client
+
+	return client, nil
+}
+
+func (r *Run) Name() string {
+	return "run"
+}
+
+func (r *Run) Start() error {
+	for _, component := range r.components {
+		slog.Info("Starting component", "name", component.Name())
+
+		if err := component.Start(); err != nil {
+			slog.Error("Starting component error", "name", component.Name(), "error", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Run) Close(ctx context.Context) {
+	for i := len(r.components) - 1; i >= 0; i-- {
+		r.components[i].Close(ctx)
+
+		slog.Info("Component stopped", "name", r.components[i].Name())
+	}
+
+	r.closeShared()
+}
+
+// closeShared closes the DB pool and RPC clients the components were sharing.
+func (r *Run) closeShared() {
+	if err := r.db.Close(); err != nil {
+		slog.Error("Failed to close db connection", "err", err)
+	}
+
+	r.rpcClientsMu.Lock()
+	defer r.rpcClientsMu.Unlock()
+
+	for _, client := range r.rpcClients {
+		client.Close()
+	}
+}
@@ -0,0 +1,41 @@
+package run
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+func Test_dialRPCClient(t *testing.T) {
+	dialed := 0
+
+	r := &Run{
+		db:         &mock.DB{},
+		rpcClients: make(map[string]*rpc.Client),
+		dialFunc: func(url string) (*rpc.Client, error) {
+			dialed++
+
+			return rpc.DialInProc(rpc.NewServer()), nil
+		},
+	}
+
+	src, err := r.dialRPCClient("srcRpcUrl")
+	assert.Nil(t, err)
+
+	srcAgain, err := r.dialRPCClient("srcRpcUrl")
+	assert.Nil(t, err)
+
+	dest, err := r.dialRPCClient("destRpcUrl")
+	assert.Nil(t, err)
+
+	// each url is dialed once, and the connection shared by everyone asking for it.
+	assert.Equal(t, 2, dialed)
+	assert.Same(t, src, srcAgain)
+	assert.NotSame(t, src, dest)
+
+	r.Close(context.Background())
+}
@@ -0,0 +1,42 @@
+package relayer
+
+import (
+	"context"
+	"time"
+)
+
+// SponsoredGasUsage is the gas the relayer has spent processing messages it sponsored
+// under a policy, for one address on one day.
+type SponsoredGasUsage struct {
+	ID        int       `json:"id"`
+	Policy    string    `json:"policy"`
+	Address   string    `json:"address"`
+	Day       string    `json:"day"`
+	GasUsed   uint64    `json:"gasUsed"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// AddSponsoredGasUsageOpts
+type AddSponsoredGasUsageOpts struct {
+	Policy  string
+	Address string
+	// Day is a UTC date, formatted as 2006-01-02.
+	Day     string
+	GasUsed uint64
+}
+
+// FindSponsoredGasUsageOpts selects the gas sponsored under a policy on a day, by
+// every address unless Address is set.
+type FindSponsoredGasUsageOpts struct {
+	Policy  string
+	Address *string
+	Day     string
+}
+
+// SponsoredGasUsageRepository is used to track the gas sponsored under each policy
+type SponsoredGasUsageRepository interface {
+	// Add adds opts.GasUsed to the gas sponsored for the address on the day.
+	Add(ctx context.Context, opts AddSponsoredGasUsageOpts) error
+	GasUsed(ctx context.Context, opts FindSponsoredGasUsageOpts) (uint64, error)
+}
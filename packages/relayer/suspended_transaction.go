@@ -0,0 +1,40 @@
+package relayer
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/morkid/paginate"
+)
+
+// SuspendedTransaction represents a single bridge message, by msgHash, which the
+// relayer should not process until it has been unsuspended.
+type SuspendedTransaction struct {
+	ID           int       `json:"id"`
+	MessageID    int       `json:"messageID"`
+	SrcChainID   int64     `json:"srcChainID"`
+	DestChainID  int64     `json:"destChainID"`
+	Suspended    bool      `json:"suspended"`
+	MsgHash      string    `json:"msgHash"`
+	MessageOwner string    `json:"messageOwner"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// SuspendTransactionOpts
+type SuspendTransactionOpts struct {
+	MessageID    int
+	SrcChainID   int64
+	DestChainID  int64
+	MsgHash      string
+	MessageOwner string
+}
+
+// SuspendedTransactionRepository is used to interact with suspended transactions in the store
+type SuspendedTransactionRepository interface {
+	Suspend(ctx context.Context, opts SuspendTransactionOpts) (*SuspendedTransaction, error)
+	Unsuspend(ctx context.Context, msgHash string) error
+	IsSuspended(ctx context.Context, msgHash string) (bool, error)
+	FindAllSuspended(ctx context.Context, req *http.Request) (*paginate.Page, error)
+}
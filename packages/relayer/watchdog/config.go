@@ -10,9 +10,8 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
-	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/rabbitmq"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/backend"
 	"github.com/urfave/cli/v2"
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
@@ -32,11 +31,15 @@ type Config struct {
 	ConfirmationsTimeout uint64
 	EnableTaikoL2        bool
 
+	// pause both bridges on a forged message, in addition to suspending it
+	PauseBridgesOnForgedMessages bool
+
 	// backoff configs
 	BackoffRetryInterval uint64
 	BackOffMaxRetrys     uint64
 
 	// db configs
+	DatabaseDialect         string
 	DatabaseUsername        string
 	DatabasePassword        string
 	DatabaseName            string
@@ -45,6 +48,8 @@ type Config struct {
 	DatabaseMaxOpenConns    uint64
 	DatabaseMaxConnLifetime uint64
 	// queue configs
+	QueueType     string
+	QueueDataDir  string
 	QueueUsername string
 	QueuePassword string
 	QueueHost     string
@@ -71,31 +76,36 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 	}
 
 	return &Config{
-		WatchdogPrivateKey:      watchdogPrivateKey,
-		DestBridgeAddress:       common.HexToAddress(c.String(flags.DestBridgeAddress.Name)),
-		SrcBridgeAddress:        common.HexToAddress(c.String(flags.SrcBridgeAddress.Name)),
-		DatabaseUsername:        c.String(flags.DatabaseUsername.Name),
-		DatabasePassword:        c.String(flags.DatabasePassword.Name),
-		DatabaseName:            c.String(flags.DatabaseName.Name),
-		DatabaseHost:            c.String(flags.DatabaseHost.Name),
-		DatabaseMaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
-		DatabaseMaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
-		DatabaseMaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
-		QueueUsername:           c.String(flags.QueueUsername.Name),
-		QueuePassword:           c.String(flags.QueuePassword.Name),
-		QueuePort:               c.Uint64(flags.QueuePort.Name),
-		QueueHost:               c.String(flags.QueueHost.Name),
-		QueuePrefetch:           c.Uint64(flags.QueuePrefetchCount.Name),
-		SrcRPCUrl:               c.String(flags.SrcRPCUrl.Name),
-		DestRPCUrl:              c.String(flags.DestRPCUrl.Name),
-		Confirmations:           c.Uint64(flags.Confirmations.Name),
-		ConfirmationsTimeout:    c.Uint64(flags.ConfirmationTimeout.Name),
-		EnableTaikoL2:           c.Bool(flags.EnableTaikoL2.Name),
-		BackoffRetryInterval:    c.Uint64(flags.BackOffRetryInterval.Name),
-		BackOffMaxRetrys:        c.Uint64(flags.BackOffMaxRetrys.Name),
-		ETHClientTimeout:        c.Uint64(flags.ETHClientTimeout.Name),
+		WatchdogPrivateKey:           watchdogPrivateKey,
+		DestBridgeAddress:            common.HexToAddress(c.String(flags.DestBridgeAddress.Name)),
+		SrcBridgeAddress:             common.HexToAddress(c.String(flags.SrcBridgeAddress.Name)),
+		DatabaseDialect:              c.String(flags.DatabaseDialect.Name),
+		DatabaseUsername:             c.String(flags.DatabaseUsername.Name),
+		DatabasePassword:             c.String(flags.DatabasePassword.Name),
+		DatabaseName:                 c.String(flags.DatabaseName.Name),
+		DatabaseHost:                 c.String(flags.DatabaseHost.Name),
+		DatabaseMaxIdleConns:         c.Uint64(flags.DatabaseMaxIdleConns.Name),
+		DatabaseMaxOpenConns:         c.Uint64(flags.DatabaseMaxOpenConns.Name),
+		DatabaseMaxConnLifetime:      c.Uint64(flags.DatabaseConnMaxLifetime.Name),
+		QueueType:                    c.String(flags.QueueType.Name),
+		QueueDataDir:                 c.String(flags.QueueDataDir.Name),
+		QueueUsername:                c.String(flags.QueueUsername.Name),
+		QueuePassword:                c.String(flags.QueuePassword.Name),
+		QueuePort:                    c.Uint64(flags.QueuePort.Name),
+		QueueHost:                    c.String(flags.QueueHost.Name),
+		QueuePrefetch:                c.Uint64(flags.QueuePrefetchCount.Name),
+		SrcRPCUrl:                    c.String(flags.SrcRPCUrl.Name),
+		DestRPCUrl:                   c.String(flags.DestRPCUrl.Name),
+		Confirmations:                c.Uint64(flags.Confirmations.Name),
+		ConfirmationsTimeout:         c.Uint64(flags.ConfirmationTimeout.Name),
+		EnableTaikoL2:                c.Bool(flags.EnableTaikoL2.Name),
+		PauseBridgesOnForgedMessages: c.Bool(flags.PauseBridgesOnForgedMessages.Name),
+		BackoffRetryInterval:         c.Uint64(flags.BackOffRetryInterval.Name),
+		BackOffMaxRetrys:             c.Uint64(flags.BackOffMaxRetrys.Name),
+		ETHClientTimeout:             c.Uint64(flags.ETHClientTimeout.Name),
 		OpenDBFunc: func() (db.DB, error) {
 			return db.OpenDBConnection(db.DBConnectionOpts{
+				Dialect:         db.Dialect(c.String(flags.DatabaseDialect.Name)),
 				Name:            c.String(flags.DatabaseUsername.Name),
 				Password:        c.String(flags.DatabasePassword.Name),
 				Database:        c.String(flags.DatabaseName.Name),
@@ -103,8 +113,8 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 				MaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
 				MaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
 				MaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
-				OpenFunc: func(dsn string) (db.DB, error) {
-					gormDB, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+				OpenFunc: func(dialector gorm.Dialector) (db.DB, error) {
+					gormDB, err := gorm.Open(dialector, &gorm.Config{
 						Logger: logger.Default.LogMode(logger.Silent),
 					})
 					if err != nil {
@@ -122,9 +132,10 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 				Host:          c.String(flags.QueueHost.Name),
 				Port:          c.String(flags.QueuePort.Name),
 				PrefetchCount: c.Uint64(flags.QueuePrefetchCount.Name),
+				DataDir:       c.String(flags.QueueDataDir.Name),
 			}
 
-			q, err := rabbitmq.NewQueue(opts)
+			q, err := backend.Open(queue.Type(c.String(flags.QueueType.Name)), opts)
 			if err != nil {
 				return nil, err
 			}
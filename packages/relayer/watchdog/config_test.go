@@ -48,6 +48,8 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		assert.Equal(t, "dbpass", c.DatabasePassword)
 		assert.Equal(t, "dbname", c.DatabaseName)
 		assert.Equal(t, "dbhost", c.DatabaseHost)
+		assert.Equal(t, "inprocess", c.QueueType)
+		assert.Equal(t, "queuedatadir", c.QueueDataDir)
 		assert.Equal(t, "queuename", c.QueueUsername)
 		assert.Equal(t, "queuepassword", c.QueuePassword)
 		assert.Equal(t, "queuehost", c.QueueHost)
@@ -65,6 +67,7 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		assert.Equal(t, uint64(30), c.DatabaseMaxConnLifetime)
 		assert.Equal(t, uint64(10), c.ETHClientTimeout)
 		assert.Equal(t, uint64(100), c.QueuePrefetch)
+		assert.Equal(t, true, c.PauseBridgesOnForgedMessages)
 
 		c.OpenDBFunc = func() (db.DB, error) {
 			return &mock.DB{}, nil
@@ -85,6 +88,8 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		"--" + flags.DatabasePassword.Name, "dbpass",
 		"--" + flags.DatabaseHost.Name, "dbhost",
 		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.QueueType.Name, "inprocess",
+		"--" + flags.QueueDataDir.Name, "queuedatadir",
 		"--" + flags.QueueUsername.Name, "queuename",
 		"--" + flags.QueuePassword.Name, "queuepassword",
 		"--" + flags.QueueHost.Name, "queuehost",
@@ -103,5 +108,6 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		"--" + flags.DatabaseConnMaxLifetime.Name, databaseMaxConnLifetime,
 		"--" + flags.ETHClientTimeout.Name, ethClientTimeout,
 		"--" + flags.QueuePrefetchCount.Name, "100",
+		"--" + flags.PauseBridgesOnForgedMessages.Name,
 	}))
 }
@@ -4,7 +4,6 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"encoding/json"
-	"fmt"
 	"log/slog"
 	"math/big"
 	"sync"
@@ -46,7 +45,8 @@ type ethClient interface {
 type Watchdog struct {
 	cancel context.CancelFunc
 
-	eventRepo relayer.EventRepository
+	eventRepo       relayer.EventRepository
+	suspendedTxRepo relayer.SuspendedTransactionRepository
 
 	queue queue.Queue
 
@@ -102,6 +102,11 @@ func InitFromConfig(ctx context.Context, w *Watchdog, cfg *Config) error {
 		return err
 	}
 
+	suspendedTxRepository, err := repo.NewSuspendedTransactionRepository(db)
+	if err != nil {
+		return err
+	}
+
 	srcEthClient, err := ethclient.Dial(cfg.SrcRPCUrl)
 	if err != nil {
 		return err
@@ -165,6 +170,7 @@ func InitFromConfig(ctx context.Context, w *Watchdog, cfg *Config) error {
 	}
 
 	w.eventRepo = eventRepository
+	w.suspendedTxRepo = suspendedTxRepository
 
 	w.srcEthClient = srcEthClient
 	w.destEthClient = destEthClient
@@ -250,7 +256,7 @@ func (w *Watchdog) Start() error {
 }
 
 func (w *Watchdog) queueName() string {
-	return fmt.Sprintf("%v-%v-%v-queue", w.srcChainId.String(), w.destChainId.String(), relayer.EventNameMessageProcessed)
+	return queue.Name(w.srcChainId, w.destChainId, relayer.EventNameMessageProcessed)
 }
 
 func (w *Watchdog) eventLoop(ctx context.Context) {
@@ -284,7 +290,7 @@ func (w *Watchdog) eventLoop(ctx context.Context) {
 
 // checkMessage checks a MessageReceived event message and makes sure
 // that the message was actually sent on the source chain. If it wasn't,
-// we send a suspend transaction.
+// we suspend the message, and only pause both bridges if configured to.
 func (w *Watchdog) checkMessage(ctx context.Context, msg queue.Message) error {
 	msgBody := &queue.QueueMessageProcessedBody{}
 	if err := json.Unmarshal(msg.Body, msgBody); err != nil {
@@ -316,6 +322,26 @@ func (w *Watchdog) checkMessage(ctx context.Context, msg queue.Message) error {
 	// we should alert based on this metric
 	relayer.BridgeMessageNotSent.Inc()
 
+	// messages queued before the msgHash was added to the queue body
+	// have theirs computed by the bridge.
+	msgHash := msgBody.MsgHash
+	if msgHash == relayer.ZeroHash {
+		msgHash, err = w.destBridge.HashMessage(&bind.CallOpts{Context: ctx}, msgBody.Message)
+		if err != nil {
+			return errors.Wrap(err, "w.destBridge.HashMessage")
+		}
+	}
+
+	// we only suspend this individual message rather than halting the whole bridge,
+	// unless configured to pause both bridges too.
+	if err := w.suspendMessage(ctx, msgBody, msgHash); err != nil {
+		return err
+	}
+
+	if !w.cfg.PauseBridgesOnForgedMessages {
+		return nil
+	}
+
 	pauseReceipt, err := w.pauseBridge(ctx, w.srcBridge, w.cfg.SrcBridgeAddress, w.srcTxmgr)
 	if err != nil {
 		return err
@@ -402,3 +428,31 @@ func (w *Watchdog) pauseBridge(
 
 	return receipt, nil
 }
+
+// suspendMessage records the message as suspended, so no processor will attempt
+// to process it until it has been unsuspended.
+func (w *Watchdog) suspendMessage(
+	ctx context.Context,
+	msgBody *queue.QueueMessageProcessedBody,
+	msgHash [32]byte,
+) error {
+	s, err := w.suspendedTxRepo.Suspend(ctx, relayer.SuspendTransactionOpts{
+		MessageID:    int(msgBody.Message.Id),
+		SrcChainID:   int64(msgBody.Message.SrcChainId),
+		DestChainID:  int64(msgBody.Message.DestChainId),
+		MsgHash:      common.Hash(msgHash).Hex(),
+		MessageOwner: msgBody.Message.SrcOwner.Hex(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "w.suspendedTxRepo.Suspend")
+	}
+
+	slog.Info("suspended message",
+		"msgHash", s.MsgHash,
+		"msgId", s.MessageID,
+	)
+
+	relayer.MessagesSuspended.Inc()
+
+	return nil
+}
@@ -1,10 +1,19 @@
 package watchdog
 
 import (
+	"context"
+	"encoding/json"
 	"math/big"
 	"testing"
 
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
 )
 
 func Test_Name(t *testing.T) {
@@ -21,3 +30,100 @@ func Test_queueName(t *testing.T) {
 
 	assert.Equal(t, "1-2-MessageProcessed-queue", w.queueName())
 }
+
+// pauseTxManager returns a successful receipt for every transaction sent, and records the candidates.
+type pauseTxManager struct {
+	mock.TxManager
+	candidates []txmgr.TxCandidate
+}
+
+func (t *pauseTxManager) Send(ctx context.Context, candidate txmgr.TxCandidate) (*types.Receipt, error) {
+	t.candidates = append(t.candidates, candidate)
+
+	return &types.Receipt{Status: types.ReceiptStatusSuccessful}, nil
+}
+
+func Test_checkMessage_forgedMessage(t *testing.T) {
+	tests := []struct {
+		name         string
+		pauseBridges bool
+		msgHash      [32]byte
+		wantPaused   bool
+	}{
+		{
+			"suspendsMessage",
+			false,
+			mock.SuccessMsgHash,
+			false,
+		},
+		{
+			"suspendsMessageWithoutQueuedMsgHash",
+			false,
+			relayer.ZeroHash,
+			false,
+		},
+		{
+			"suspendsMessageAndPausesBridges",
+			true,
+			mock.SuccessMsgHash,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				srcTxmgr  = &pauseTxManager{}
+				destTxmgr = &pauseTxManager{}
+			)
+
+			w := Watchdog{
+				srcBridge:       &mock.Bridge{},
+				destBridge:      &mock.Bridge{},
+				srcTxmgr:        srcTxmgr,
+				destTxmgr:       destTxmgr,
+				suspendedTxRepo: mock.NewSuspendedTransactionRepository(),
+				cfg: &Config{
+					SrcBridgeAddress:             common.HexToAddress("0x1"),
+					DestBridgeAddress:            common.HexToAddress("0x2"),
+					PauseBridgesOnForgedMessages: tt.pauseBridges,
+				},
+			}
+
+			body := queue.QueueMessageProcessedBody{
+				Message: bridge.IBridgeMessage{
+					Id:          1,
+					SrcChainId:  1,
+					DestChainId: 2,
+				},
+				MsgHash: tt.msgHash,
+				ID:      1,
+			}
+
+			marshalled, err := json.Marshal(body)
+			assert.Nil(t, err)
+
+			assert.Nil(t, w.checkMessage(context.Background(), queue.Message{Body: marshalled}))
+
+			// a forged message is always suspended.
+			suspended, err := w.suspendedTxRepo.IsSuspended(
+				context.Background(),
+				common.Hash(mock.SuccessMsgHash).Hex(),
+			)
+			assert.Nil(t, err)
+			assert.True(t, suspended)
+
+			if !tt.wantPaused {
+				assert.Equal(t, 0, len(srcTxmgr.candidates))
+				assert.Equal(t, 0, len(destTxmgr.candidates))
+
+				return
+			}
+
+			assert.Equal(t, 1, len(srcTxmgr.candidates))
+			assert.Equal(t, w.cfg.SrcBridgeAddress, *srcTxmgr.candidates[0].To)
+			assert.Equal(t, 1, len(destTxmgr.candidates))
+			assert.Equal(t, w.cfg.DestBridgeAddress, *destTxmgr.candidates[0].To)
+		})
+	}
+}
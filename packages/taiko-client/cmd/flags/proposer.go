@@ -46,6 +46,12 @@ var (
 		Category: proposerCategory,
 		EnvVars:  []string{"TIER_FEE_SGX"},
 	}
+	SgxAndZkVMTierFee = &cli.Float64Flag{
+		Name:     "tierFee.sgxAndZkvm",
+		Usage:    "Initial tier fee (in GWei) paid to prover to generate a SGX + zkVM proofs",
+		Category: proposerCategory,
+		EnvVars:  []string{"TIER_FEE_SGX_AND_ZKVM"},
+	}
 	TierFeePriceBump = &cli.Uint64Flag{
 		Name:     "tierFee.priceBump",
 		Usage:    "Price bump percentage when no prover wants to accept the block at initial fee",
@@ -125,6 +131,36 @@ var (
 		Category: proposerCategory,
 		EnvVars:  []string{"INCLUDE_PARENT_META_HASH"},
 	}
+	// Transactions selection related.
+	TxPoolOrdering = &cli.StringFlag{
+		Name:     "txPool.ordering",
+		Usage:    "Policy to order each proposed transactions list with, one of pool, localFirst and priorityFee",
+		Value:    "pool",
+		Category: proposerCategory,
+		EnvVars:  []string{"TX_POOL_ORDERING"},
+	}
+	ProfitAware = &cli.BoolFlag{
+		Name: "epoch.profitAware",
+		Usage: "Skip the transactions lists whose fees do not cover the L1 cost of proposing them, " +
+			"until epoch.minProposingInterval has passed",
+		Value:    false,
+		Category: proposerCategory,
+		EnvVars:  []string{"EPOCH_PROFIT_AWARE"},
+	}
+	ProposeBlockGas = &cli.Uint64Flag{
+		Name:     "epoch.proposeBlockGas",
+		Usage:    "Estimated L1 gas used by a TaikoL1.proposeBlock transaction, besides its transactions list data",
+		Value:    250_000,
+		Category: proposerCategory,
+		EnvVars:  []string{"EPOCH_PROPOSE_BLOCK_GAS"},
+	}
+	BaseFeeSharingPctg = &cli.Uint64Flag{
+		Name:     "epoch.baseFeeSharingPctg",
+		Usage:    "Percentage of the L2 base fee paid to the proposer, counted as revenue by epoch.profitAware",
+		Value:    0,
+		Category: proposerCategory,
+		EnvVars:  []string{"EPOCH_BASE_FEE_SHARING_PCTG"},
+	}
 	// Transaction related.
 	BlobAllowed = &cli.BoolFlag{
 		Name:    "l1.blobAllowed",
@@ -132,6 +168,20 @@ var (
 		Value:   false,
 		EnvVars: []string{"L1_BLOB_ALLOWED"},
 	}
+	BlobCostAware = &cli.BoolFlag{
+		Name:     "l1.blobCostAware",
+		Usage:    "Propose each block with whichever of a blob and calldata is cheaper, requires l1.blobAllowed",
+		Value:    false,
+		Category: proposerCategory,
+		EnvVars:  []string{"L1_BLOB_COST_AWARE"},
+	}
+	BlobSwitchThreshold = &cli.Uint64Flag{
+		Name:     "l1.blobSwitchThreshold",
+		Usage:    "Percentage by which the other of blob and calldata must be cheaper before switching to it",
+		Value:    10,
+		Category: proposerCategory,
+		EnvVars:  []string{"L1_BLOB_SWITCH_THRESHOLD"},
+	}
 	L1BlockBuilderTip = &cli.Uint64Flag{
 		Name:     "l1.blockBuilderTip",
 		Usage:    "Amount you wish to tip the L1 block builder",
@@ -139,6 +189,28 @@ var (
 		Category: proposerCategory,
 		EnvVars:  []string{"L1_BLOCK_BUILDER_TIP"},
 	}
+	TxListCompression = &cli.StringFlag{
+		Name: "txList.compression",
+		Usage: "Codec to compress proposed transactions lists with, only zlib until a protocol fork " +
+			"activates brotli and zstd",
+		Value:    "zlib",
+		Category: proposerCategory,
+		EnvVars:  []string{"TXLIST_COMPRESSION"},
+	}
+	// Admin server related.
+	AdminServerPort = &cli.Uint64Flag{
+		Name:     "admin.port",
+		Usage:    "Port to expose the proposer admin HTTP server on, 0 to disable it",
+		Value:    0,
+		Category: proposerCategory,
+		EnvVars:  []string{"ADMIN_PORT"},
+	}
+	AdminToken = &cli.StringFlag{
+		Name:     "admin.token",
+		Usage:    "Bearer token the proposer admin HTTP server requests must carry, required by admin.port",
+		Category: proposerCategory,
+		EnvVars:  []string{"ADMIN_TOKEN"},
+	}
 )
 
 // ProposerFlags All proposer flags.
@@ -157,12 +229,22 @@ var ProposerFlags = MergeFlags(CommonFlags, []cli.Flag{
 	MinTxListBytes,
 	MinProposingInternal,
 	MaxProposedTxListsPerEpoch,
+	TxPoolOrdering,
+	ProfitAware,
+	ProposeBlockGas,
+	BaseFeeSharingPctg,
 	ProverEndpoints,
 	OptimisticTierFee,
 	SgxTierFee,
+	SgxAndZkVMTierFee,
 	TierFeePriceBump,
 	MaxTierFeePriceBumps,
 	ProposeBlockIncludeParentMetaHash,
 	BlobAllowed,
+	BlobCostAware,
+	BlobSwitchThreshold,
 	L1BlockBuilderTip,
+	TxListCompression,
+	AdminServerPort,
+	AdminToken,
 }, TxmgrFlags)
@@ -30,10 +30,31 @@ var (
 var (
 	RaikoHostEndpoint = &cli.StringFlag{
 		Name:     "raiko.host",
-		Usage:    "RPC endpoint of a Raiko host service",
+		Usage:    "Comma-delineated list of RPC endpoints of Raiko host services, proof requests are spread across them",
 		Category: proverCategory,
 		EnvVars:  []string{"RAIKO_HOST"},
 	}
+	RaikoHedgeDelay = &cli.DurationFlag{
+		Name: "raiko.hedgeDelay",
+		Usage: "Time after which a proof request still in progress is sent to another Raiko host too, " +
+			"0 to disable hedging",
+		Value:    10 * time.Minute,
+		Category: proverCategory,
+		EnvVars:  []string{"RAIKO_HEDGE_DELAY"},
+	}
+	RaikoZKVMHostEndpoint = &cli.StringFlag{
+		Name:     "raiko.zkvmHost",
+		Usage:    "RPC endpoint of the Raiko host service generating the zkVM proofs, the first raiko.host by default",
+		Category: proverCategory,
+		EnvVars:  []string{"RAIKO_ZKVM_HOST"},
+	}
+	RaikoZKVMProofType = &cli.StringFlag{
+		Name:     "raiko.zkvmProofType",
+		Usage:    "Type of the zkVM proofs generated for the SGX + zkVM tier, only risc0 is supported",
+		Value:    "risc0",
+		Category: proverCategory,
+		EnvVars:  []string{"RAIKO_ZKVM_PROOF_TYPE"},
+	}
 	RaikoJWTPath = &cli.StringFlag{
 		Name:     "raiko.jwtPath",
 		Usage:    "Path to a JWT secret for the Raiko service",
@@ -201,6 +222,14 @@ var (
 		Category: proverCategory,
 		EnvVars:  []string{"PROVER_BLOCK_CONFIRMATIONS"},
 	}
+	// Proof job store related.
+	JobStorePath = &cli.StringFlag{
+		Name: "prover.jobStorePath",
+		Usage: "Directory of the on-disk store of the proof jobs, so that the proofs in progress survive restarts, " +
+			"proof jobs are only kept in memory if not set",
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_JOB_STORE_PATH"},
+	}
 )
 
 // ProverFlags All prover flags.
@@ -210,6 +239,9 @@ var ProverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	L2HTTPEndpoint,
 	RaikoHostEndpoint,
 	RaikoJWTPath,
+	RaikoHedgeDelay,
+	RaikoZKVMHostEndpoint,
+	RaikoZKVMProofType,
 	L1ProverPrivKey,
 	MinOptimisticTierFee,
 	MinSgxTierFee,
@@ -235,4 +267,5 @@ var ProverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	L1NodeVersion,
 	L2NodeVersion,
 	BlockConfirmations,
+	JobStorePath,
 }, TxmgrFlags)
@@ -215,6 +215,7 @@ func (s *BlobSyncerTestSuite) initProposer() {
 		ProverEndpoints:            s.ProverEndpoints,
 		OptimisticTierFee:          common.Big256,
 		SgxTierFee:                 common.Big256,
+		SgxAndZkVMTierFee:          common.Big256,
 		MaxTierFeePriceBumps:       3,
 		TierFeePriceBump:           common.Big2,
 		L1BlockBuilderTip:          common.Big0,
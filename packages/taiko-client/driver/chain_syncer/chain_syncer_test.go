@@ -66,6 +66,7 @@ func (s *ChainSyncerTestSuite) SetupTest() {
 		ProverEndpoints:            s.ProverEndpoints,
 		OptimisticTierFee:          common.Big256,
 		SgxTierFee:                 common.Big256,
+		SgxAndZkVMTierFee:          common.Big256,
 		MaxTierFeePriceBumps:       3,
 		TierFeePriceBump:           common.Big2,
 		ExtraData:                  "test",
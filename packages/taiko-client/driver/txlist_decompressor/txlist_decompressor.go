@@ -5,16 +5,22 @@ import (
 
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
 )
 
+// txEnvelopeMaxBytes is an upper bound of the RLP encoded size of a transaction besides its data
+// and access list: its type, chain ID, nonce, fees, gas limit, recipient, value and signature.
+const txEnvelopeMaxBytes = 256
+
 // TxListDecompressor is responsible for validating and decompressing
 // the transactions list in a TaikoL1.proposeBlock transaction.
 type TxListDecompressor struct {
 	blockMaxGasLimit  uint64
 	maxBytesPerTxList uint64
+	maxTxListBytes    uint64
 	chainID           *big.Int
 }
 
@@ -27,6 +33,7 @@ func NewTxListDecompressor(
 	return &TxListDecompressor{
 		blockMaxGasLimit:  blockMaxGasLimit,
 		maxBytesPerTxList: maxBytesPerTxList,
+		maxTxListBytes:    maxDecompressedTxListBytes(blockMaxGasLimit),
 		chainID:           chainID,
 	}
 }
@@ -60,8 +67,8 @@ func (v *TxListDecompressor) TryDecompress(
 		err error
 	)
 
-	// Decompress the transaction list bytes.
-	if txListBytes, err = utils.Decompress(txListBytes); err != nil {
+	// Decompress the transaction list bytes, a larger transaction list could not fit in the block anyway.
+	if txListBytes, err = utils.Decompress(txListBytes, v.maxTxListBytes); err != nil {
 		log.Info("Failed to decompress tx list bytes", "blockID", blockID, "error", err)
 		return []byte{}
 	}
@@ -75,3 +82,10 @@ func (v *TxListDecompressor) TryDecompress(
 	log.Info("Transaction list is valid", "blockID", blockID)
 	return txListBytes
 }
+
+// maxDecompressedTxListBytes returns the largest transactions list a block with the given gas limit
+// can include: its data costs at least params.TxDataZeroGas per byte, and each of its transactions
+// at least params.TxGas.
+func maxDecompressedTxListBytes(blockMaxGasLimit uint64) uint64 {
+	return blockMaxGasLimit/params.TxDataZeroGas + (blockMaxGasLimit/params.TxGas+1)*txEnvelopeMaxBytes
+}
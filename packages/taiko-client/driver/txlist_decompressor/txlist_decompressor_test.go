@@ -50,13 +50,36 @@ func (s *TxListDecompressorTestSuite) TestCalldataSize() {
 func (s *TxListDecompressorTestSuite) TestValidTxList() {
 	compressed, err := utils.Compress(rlpEncodedTransactionBytes(1, true))
 	s.Nil(err)
-	decompressed, err := utils.Decompress(compressed)
+	decompressed, err := utils.Decompress(compressed, rpc.BlockMaxTxListBytes)
 	s.Nil(err)
 
 	s.Equal(s.d.TryDecompress(chainID, compressed, true), decompressed)
 	s.Equal(s.d.TryDecompress(chainID, compressed, false), decompressed)
 }
 
+func (s *TxListDecompressorTestSuite) TestOtherCodecsNotActivated() {
+	for _, name := range []string{utils.CodecBrotli, utils.CodecZstd} {
+		codec, err := utils.TxListCodecByName(name)
+		s.Nil(err)
+
+		compressed, err := codec.Compress(rlpEncodedTransactionBytes(1, true))
+		s.Nil(err)
+
+		// Like drivers and provers which only support zlib, the block is derived as empty.
+		s.Empty(s.d.TryDecompress(chainID, compressed, true))
+	}
+}
+
+func (s *TxListDecompressorTestSuite) TestCompressionBomb() {
+	d := NewTxListDecompressor(1_000_000, rpc.BlockMaxTxListBytes, chainID)
+
+	compressed, err := utils.Compress(make([]byte, 10*maxDecompressedTxListBytes(1_000_000)))
+	s.Nil(err)
+	s.Less(len(compressed), rpc.BlockMaxTxListBytes)
+
+	s.Empty(d.TryDecompress(chainID, compressed, true))
+}
+
 func (s *TxListDecompressorTestSuite) TestInvalidTxList() {
 	compressed, err := utils.Compress(randBytes(1024))
 	s.Nil(err)
@@ -29,6 +29,17 @@ var (
 	ProposerProposeEpochCounter    = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_epoch"})
 	ProposerProposedTxListsCounter = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_proposed_txLists"})
 	ProposerProposedTxsCounter     = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_proposed_txs"})
+	ProposerBlobTxListsCounter     = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_txLists_blob"})
+	ProposerCalldataTxListsCounter = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_txLists_calldata"})
+	ProposerEstimatedBlobCostGauge = factory.NewGauge(prometheus.GaugeOpts{
+		Name: "proposer_txList_estimated_blob_cost",
+	})
+	ProposerEstimatedCalldataCostGauge = factory.NewGauge(prometheus.GaugeOpts{
+		Name: "proposer_txList_estimated_calldata_cost",
+	})
+	ProposerUnprofitableTxListsCounter = factory.NewCounter(prometheus.CounterOpts{
+		Name: "proposer_txLists_unprofitable",
+	})
 
 	// Prover
 	ProverLatestVerifiedIDGauge      = factory.NewGauge(prometheus.GaugeOpts{Name: "prover_latestVerified_id"})
@@ -49,9 +60,21 @@ var (
 	ProverSgxProofGeneratedCounter = factory.NewCounter(prometheus.CounterOpts{
 		Name: "prover_proof_sgx_generated",
 	})
+	ProverZKvmProofGeneratedCounter = factory.NewCounter(prometheus.CounterOpts{
+		Name: "prover_proof_zkvm_generated",
+	})
 	ProverSubmissionRevertedCounter = factory.NewCounter(prometheus.CounterOpts{
 		Name: "prover_proof_submission_reverted",
 	})
+	ProverProofProducerRequestsCounter = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "prover_proof_producer_requests",
+	}, []string{"backend", "result"})
+	ProverProofProducerLatencyGauge = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "prover_proof_producer_latency_seconds",
+	}, []string{"backend"})
+	ProverProofProducerSuccessRateGauge = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "prover_proof_producer_success_rate",
+	}, []string{"backend"})
 
 	// TxManager
 	TxMgrMetrics = txmgrMetrics.MakeTxMetrics("client", factory)
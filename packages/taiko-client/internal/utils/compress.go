@@ -0,0 +1,213 @@
+package utils
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Names of the supported txList compression codecs.
+const (
+	CodecZlib   = "zlib"
+	CodecBrotli = "brotli"
+	CodecZstd   = "zstd"
+)
+
+// Version bytes prefixed to txLists compressed with a codec other than zlib. zlib compressed
+// txLists are not prefixed, so that blocks proposed before codecs were introduced still decode.
+// A zlib stream always starts with a byte whose lower four bits are 8 (deflate), which none of
+// the version bytes have.
+const (
+	codecVersionBrotli byte = 0x01
+	codecVersionZstd   byte = 0x02
+)
+
+var (
+	// DefaultTxListCodec is the codec txLists are compressed with unless another one is configured.
+	DefaultTxListCodec TxListCodec = &zlibCodec{}
+
+	ErrUnknownCodec        = errors.New("unknown txList compression codec")
+	ErrCodecNotActivated   = errors.New("txList compression codec not activated by the protocol")
+	ErrEmptyCompressedData = errors.New("empty compressed txList")
+	ErrTxListTooLarge      = errors.New("decompressed txList too large")
+)
+
+// TxListCodec compresses and decompresses transactions list bytes, decompressing at most maxBytes.
+type TxListCodec interface {
+	Name() string
+	Compress(txList []byte) ([]byte, error)
+	Decompress(compressedTxList []byte, maxBytes uint64) ([]byte, error)
+}
+
+// TxListCodecByName returns the codec with the given name.
+func TxListCodecByName(name string) (TxListCodec, error) {
+	switch name {
+	case CodecZlib:
+		return &zlibCodec{}, nil
+	case CodecBrotli:
+		return &brotliCodec{}, nil
+	case CodecZstd:
+		return &zstdCodec{}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCodec, name)
+	}
+}
+
+// Compress compresses the given txList bytes using the default codec, zlib.
+func Compress(txList []byte) ([]byte, error) {
+	return DefaultTxListCodec.Compress(txList)
+}
+
+// Decompress decompresses the given txList bytes of a proposed block, to at most maxBytes.
+//
+// Only zlib is part of the protocol: the other codecs change how the L2 chain is derived, and
+// drivers and provers which only support zlib treat their blocks as empty, so their txLists
+// are rejected until a protocol fork activates them.
+func Decompress(compressedTxList []byte, maxBytes uint64) ([]byte, error) {
+	if len(compressedTxList) == 0 {
+		return nil, ErrEmptyCompressedData
+	}
+
+	if compressedTxList[0]&0x0f == 8 {
+		return (&zlibCodec{}).Decompress(compressedTxList, maxBytes)
+	}
+
+	switch compressedTxList[0] {
+	case codecVersionBrotli, codecVersionZstd:
+		return nil, fmt.Errorf("%w: version byte %#x", ErrCodecNotActivated, compressedTxList[0])
+	default:
+		return nil, fmt.Errorf("%w: version byte %#x", ErrUnknownCodec, compressedTxList[0])
+	}
+}
+
+// zlibCodec compresses txLists using zlib, without a version byte.
+type zlibCodec struct{}
+
+// Name implements the TxListCodec interface.
+func (c *zlibCodec) Name() string { return CodecZlib }
+
+// Compress implements the TxListCodec interface.
+func (c *zlibCodec) Compress(txList []byte) ([]byte, error) {
+	var b bytes.Buffer
+	w := zlib.NewWriter(&b)
+	defer w.Close()
+
+	if _, err := w.Write(txList); err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// Decompress implements the TxListCodec interface.
+func (c *zlibCodec) Decompress(compressedTxList []byte, maxBytes uint64) ([]byte, error) {
+	r, err := zlib.NewReader(bytes.NewBuffer(compressedTxList))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return readAllTolerant(r, maxBytes)
+}
+
+// brotliCodec compresses txLists using brotli at its best compression level, which is slow to
+// compress but gives the highest ratio.
+type brotliCodec struct{}
+
+// Name implements the TxListCodec interface.
+func (c *brotliCodec) Name() string { return CodecBrotli }
+
+// Compress implements the TxListCodec interface.
+func (c *brotliCodec) Compress(txList []byte) ([]byte, error) {
+	b := bytes.NewBuffer([]byte{codecVersionBrotli})
+	w := brotli.NewWriterLevel(b, brotli.BestCompression)
+
+	if _, err := w.Write(txList); err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// Decompress implements the TxListCodec interface.
+func (c *brotliCodec) Decompress(compressedTxList []byte, maxBytes uint64) ([]byte, error) {
+	if len(compressedTxList) == 0 || compressedTxList[0] != codecVersionBrotli {
+		return nil, fmt.Errorf("%w: not a brotli compressed txList", ErrUnknownCodec)
+	}
+
+	return readAllTolerant(brotli.NewReader(bytes.NewReader(compressedTxList[1:])), maxBytes)
+}
+
+// zstdCodec compresses txLists using zstd at its best compression level.
+type zstdCodec struct{}
+
+// Name implements the TxListCodec interface.
+func (c *zstdCodec) Name() string { return CodecZstd }
+
+// Compress implements the TxListCodec interface.
+func (c *zstdCodec) Compress(txList []byte) ([]byte, error) {
+	w, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedBestCompression))
+	if err != nil {
+		return nil, err
+	}
+	defer w.Close()
+
+	return w.EncodeAll(txList, []byte{codecVersionZstd}), nil
+}
+
+// Decompress implements the TxListCodec interface.
+func (c *zstdCodec) Decompress(compressedTxList []byte, maxBytes uint64) ([]byte, error) {
+	if len(compressedTxList) == 0 || compressedTxList[0] != codecVersionZstd {
+		return nil, fmt.Errorf("%w: not a zstd compressed txList", ErrUnknownCodec)
+	}
+
+	// The window is never larger than the output, bounding the memory the decoder allocates.
+	r, err := zstd.NewReader(
+		bytes.NewReader(compressedTxList[1:]),
+		zstd.WithDecoderConcurrency(1),
+		zstd.WithDecoderMaxMemory(Max(maxBytes, 1)),
+		zstd.WithDecoderMaxWindow(Max(maxBytes, zstd.MinWindowSize)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	b, err := readAllTolerant(r, maxBytes)
+	if errors.Is(err, zstd.ErrDecoderSizeExceeded) {
+		return nil, fmt.Errorf("%w: more than %d bytes", ErrTxListTooLarge, maxBytes)
+	}
+
+	return b, err
+}
+
+// readAllTolerant reads all the decompressed bytes from r, ignoring a truncated stream, as
+// the zlib decompression always has. It stops reading after maxBytes, so that a small
+// compression bomb can not exhaust the memory.
+func readAllTolerant(r io.Reader, maxBytes uint64) ([]byte, error) {
+	b, err := io.ReadAll(io.LimitReader(r, int64(maxBytes)+1))
+	if err != nil {
+		if !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, err
+		}
+	}
+
+	if uint64(len(b)) > maxBytes {
+		return nil, fmt.Errorf("%w: more than %d bytes", ErrTxListTooLarge, maxBytes)
+	}
+
+	return b, nil
+}
@@ -4,7 +4,11 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/require"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/testutils"
@@ -18,10 +22,122 @@ func TestEncodeDecodeBytes(t *testing.T) {
 	require.Nil(t, err)
 	require.NotEmpty(t, compressed)
 
-	decompressed, err := utils.Decompress(compressed)
+	decompressed, err := utils.Decompress(compressed, 1024)
 	require.Nil(t, err)
 
 	require.Equal(t, b, decompressed)
+
+	_, err = utils.Decompress(compressed, 1023)
+	require.ErrorIs(t, err, utils.ErrTxListTooLarge)
+}
+
+func TestTxListCodecs(t *testing.T) {
+	txList := testTxListBytes(t, 100)
+
+	for _, name := range []string{utils.CodecZlib, utils.CodecBrotli, utils.CodecZstd} {
+		codec, err := utils.TxListCodecByName(name)
+		require.Nil(t, err)
+		require.Equal(t, name, codec.Name())
+
+		compressed, err := codec.Compress(txList)
+		require.Nil(t, err)
+		require.Less(t, len(compressed), len(txList))
+
+		decompressed, err := codec.Decompress(compressed, uint64(len(txList)))
+		require.Nil(t, err)
+		require.Equal(t, txList, decompressed)
+
+		// The decompressed bytes are bounded, whatever the codec.
+		_, err = codec.Decompress(compressed, uint64(len(txList)-1))
+		require.ErrorIs(t, err, utils.ErrTxListTooLarge)
+	}
+}
+
+func TestDecompressCodecNotActivated(t *testing.T) {
+	txList := testTxListBytes(t, 10)
+
+	for _, name := range []string{utils.CodecBrotli, utils.CodecZstd} {
+		codec, err := utils.TxListCodecByName(name)
+		require.Nil(t, err)
+
+		compressed, err := codec.Compress(txList)
+		require.Nil(t, err)
+
+		_, err = utils.Decompress(compressed, uint64(len(txList)))
+		require.ErrorIs(t, err, utils.ErrCodecNotActivated)
+	}
+}
+
+func TestDecompressUnknownCodec(t *testing.T) {
+	_, err := utils.TxListCodecByName("lz4")
+	require.ErrorIs(t, err, utils.ErrUnknownCodec)
+
+	_, err = utils.Decompress([]byte{0xff, 0x01, 0x02}, 1024)
+	require.ErrorIs(t, err, utils.ErrUnknownCodec)
+
+	_, err = utils.Decompress([]byte{}, 1024)
+	require.ErrorIs(t, err, utils.ErrEmptyCompressedData)
+}
+
+func BenchmarkTxListCodecs(b *testing.B) {
+	txList := testTxListBytes(b, 500)
+
+	for _, name := range []string{utils.CodecZlib, utils.CodecBrotli, utils.CodecZstd} {
+		codec, err := utils.TxListCodecByName(name)
+		require.Nil(b, err)
+
+		compressed, err := codec.Compress(txList)
+		require.Nil(b, err)
+
+		b.Run(name+"/compress", func(b *testing.B) {
+			b.ReportMetric(float64(len(compressed))/float64(len(txList)), "ratio")
+			for i := 0; i < b.N; i++ {
+				if _, err := codec.Compress(txList); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+
+		b.Run(name+"/decompress", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := codec.Decompress(compressed, uint64(len(txList))); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// testTxListBytes returns the RLP encoded bytes of a list of signed transfers, which compresses
+// more like a real txList than random bytes do.
+func testTxListBytes(t testing.TB, n int) []byte {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	var (
+		signer = types.LatestSignerForChainID(big.NewInt(167001))
+		to     = common.BytesToAddress(testutils.RandomBytes(20))
+		txs    = make(types.Transactions, 0, n)
+	)
+	for i := 0; i < n; i++ {
+		tx, err := types.SignNewTx(key, signer, &types.DynamicFeeTx{
+			ChainID:   big.NewInt(167001),
+			Nonce:     uint64(i),
+			GasTipCap: big.NewInt(params.GWei),
+			GasFeeCap: big.NewInt(10 * params.GWei),
+			Gas:       21_000,
+			To:        &to,
+			Value:     big.NewInt(int64(i) * params.GWei),
+		})
+		require.Nil(t, err)
+
+		txs = append(txs, tx)
+	}
+
+	b, err := rlp.EncodeToBytes(txs)
+	require.Nil(t, err)
+
+	return b
 }
 
 func TestGWeiToWei(t *testing.T) {
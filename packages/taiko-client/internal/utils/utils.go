@@ -1,14 +1,10 @@
 package utils
 
 import (
-	"bytes"
-	"compress/zlib"
 	"crypto/rand"
 	"errors"
 	"fmt"
 	"math"
-
-	"io"
 	"math/big"
 	"os"
 	"strings"
@@ -78,41 +74,6 @@ func Max[T constraints.Integer](a, b T) T {
 	return b
 }
 
-// Compress compresses the given txList bytes using zlib.
-func Compress(txList []byte) ([]byte, error) {
-	var b bytes.Buffer
-	w := zlib.NewWriter(&b)
-	defer w.Close()
-
-	if _, err := w.Write(txList); err != nil {
-		return nil, err
-	}
-
-	if err := w.Close(); err != nil {
-		return nil, err
-	}
-
-	return b.Bytes(), nil
-}
-
-// Decompress decompresses the given txList bytes using zlib.
-func Decompress(compressedTxList []byte) ([]byte, error) {
-	r, err := zlib.NewReader(bytes.NewBuffer(compressedTxList))
-	if err != nil {
-		return nil, err
-	}
-	defer r.Close()
-
-	b, err := io.ReadAll(r)
-	if err != nil {
-		if !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
-			return nil, err
-		}
-	}
-
-	return b, nil
-}
-
 // GWeiToWei converts gwei value to wei value.
 func GWeiToWei(gwei float64) (*big.Int, error) {
 	if math.IsNaN(gwei) || math.IsInf(gwei, 0) {
@@ -259,6 +259,31 @@ func (c *Client) GetPoolContent(
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, defaultTimeout)
 	defer cancel()
 
+	baseFee, err := c.L2NextBaseFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("Current base fee", "fee", utils.WeiToGWei(baseFee))
+
+	var localsArg []string
+	for _, local := range locals {
+		localsArg = append(localsArg, local.Hex())
+	}
+
+	return c.L2Engine.TxPoolContent(
+		ctxWithTimeout,
+		beneficiary,
+		baseFee,
+		uint64(blockMaxGasLimit),
+		maxBytesPerTxList,
+		localsArg,
+		maxTransactionsLists,
+	)
+}
+
+// L2NextBaseFee fetches the base fee of the next L2 block, based on the current L1 and L2 heads.
+func (c *Client) L2NextBaseFee(ctx context.Context) (*big.Int, error) {
 	l1Head, err := c.L1.HeaderByNumber(ctx, nil)
 	if err != nil {
 		return nil, err
@@ -278,22 +303,7 @@ func (c *Client) GetPoolContent(
 		return nil, err
 	}
 
-	log.Info("Current base fee", "fee", utils.WeiToGWei(baseFeeInfo.Basefee))
-
-	var localsArg []string
-	for _, local := range locals {
-		localsArg = append(localsArg, local.Hex())
-	}
-
-	return c.L2Engine.TxPoolContent(
-		ctxWithTimeout,
-		beneficiary,
-		baseFeeInfo.Basefee,
-		uint64(blockMaxGasLimit),
-		maxBytesPerTxList,
-		localsArg,
-		maxTransactionsLists,
-	)
+	return baseFeeInfo.Basefee, nil
 }
 
 // L2AccountNonce fetches the nonce of the given L2 account at a specified height.
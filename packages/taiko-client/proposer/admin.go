@@ -0,0 +1,172 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/server"
+)
+
+// adminController implements the server.Controller interface for the proposer admin server.
+type adminController struct {
+	p *Proposer
+}
+
+// Paused implements the server.Controller interface.
+func (c *adminController) Paused() bool {
+	return c.p.isPaused()
+}
+
+// SetPaused implements the server.Controller interface.
+func (c *adminController) SetPaused(paused bool) {
+	c.p.mutex.Lock()
+	defer c.p.mutex.Unlock()
+
+	log.Info("Proposer paused status changed", "paused", paused)
+	c.p.paused = paused
+}
+
+// ForcePropose implements the server.Controller interface.
+func (c *adminController) ForcePropose() bool {
+	select {
+	case c.p.forceProposeCh <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// TierFees implements the server.Controller interface.
+func (c *adminController) TierFees() []encoding.TierFee {
+	return c.p.currentTierFees()
+}
+
+// SetTierFees implements the server.Controller interface, only the fees of the proof tiers
+// configured in the protocol can be changed.
+func (c *adminController) SetTierFees(tierFees []encoding.TierFee) error {
+	c.p.mutex.Lock()
+	defer c.p.mutex.Unlock()
+
+	updated := copyTierFees(c.p.tierFees)
+	for _, tierFee := range tierFees {
+		if tierFee.Fee == nil || tierFee.Fee.Sign() < 0 {
+			return fmt.Errorf("invalid fee for tier %d", tierFee.Tier)
+		}
+
+		found := false
+		for i := range updated {
+			if updated[i].Tier == tierFee.Tier {
+				updated[i].Fee = new(big.Int).Set(tierFee.Fee)
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("unknown tier: %d", tierFee.Tier)
+		}
+	}
+
+	log.Info("Proposer tier fees changed", "tierFees", updated)
+	c.p.tierFees = updated
+
+	return nil
+}
+
+// ProposeInterval implements the server.Controller interface.
+func (c *adminController) ProposeInterval() time.Duration {
+	c.p.mutex.RLock()
+	defer c.p.mutex.RUnlock()
+
+	return c.p.Config.ProposeInterval
+}
+
+// SetProposeInterval implements the server.Controller interface.
+func (c *adminController) SetProposeInterval(interval time.Duration) {
+	c.p.mutex.Lock()
+	defer c.p.mutex.Unlock()
+
+	log.Info("Proposer proposing interval changed", "interval", interval)
+	c.p.Config.ProposeInterval = interval
+}
+
+// MinGasUsed implements the server.Controller interface.
+func (c *adminController) MinGasUsed() uint64 {
+	c.p.mutex.RLock()
+	defer c.p.mutex.RUnlock()
+
+	return c.p.Config.MinGasUsed
+}
+
+// SetMinGasUsed implements the server.Controller interface.
+func (c *adminController) SetMinGasUsed(minGasUsed uint64) {
+	c.p.mutex.Lock()
+	defer c.p.mutex.Unlock()
+
+	log.Info("Proposer minimum gas used changed", "minGasUsed", minGasUsed)
+	c.p.Config.MinGasUsed = minGasUsed
+}
+
+// LastProposal implements the server.Controller interface.
+func (c *adminController) LastProposal() *server.ProposalResult {
+	c.p.mutex.RLock()
+	defer c.p.mutex.RUnlock()
+
+	if c.p.lastProposal == nil {
+		return nil
+	}
+
+	result := *c.p.lastProposal
+	return &result
+}
+
+// PendingNonce implements the server.Controller interface.
+func (c *adminController) PendingNonce(ctx context.Context) (uint64, error) {
+	return c.p.rpc.L1.PendingNonceAt(ctx, c.p.proposerAddress)
+}
+
+// isPaused returns whether the proposing loop is paused through the admin server.
+func (p *Proposer) isPaused() bool {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.paused
+}
+
+// currentTierFees returns a copy of the current proving fees.
+func (p *Proposer) currentTierFees() []encoding.TierFee {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return copyTierFees(p.tierFees)
+}
+
+// recordProposal records the result of the latest proposing operation.
+func (p *Proposer) recordProposal(txHash common.Hash, txNum uint, err error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	result := &server.ProposalResult{Time: time.Now(), Txs: txNum}
+	if txHash != (common.Hash{}) {
+		result.TxHash = txHash.Hex()
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	p.lastProposal = result
+}
+
+// copyTierFees deep copies the given tier fees.
+func copyTierFees(tierFees []encoding.TierFee) []encoding.TierFee {
+	copied := make([]encoding.TierFee, len(tierFees))
+	for i, tierFee := range tierFees {
+		copied[i] = encoding.TierFee{Tier: tierFee.Tier, Fee: new(big.Int).Set(tierFee.Fee)}
+	}
+
+	return copied
+}
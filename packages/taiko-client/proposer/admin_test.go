@@ -0,0 +1,75 @@
+package proposer
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+)
+
+func newTestAdminController() *adminController {
+	return &adminController{&Proposer{
+		Config: &Config{ProposeInterval: time.Minute},
+		tierFees: []encoding.TierFee{
+			{Tier: encoding.TierOptimisticID, Fee: big.NewInt(100)},
+			{Tier: encoding.TierSgxID, Fee: big.NewInt(200)},
+		},
+		forceProposeCh: make(chan struct{}, 1),
+	}}
+}
+
+func TestAdminControllerSetTierFees(t *testing.T) {
+	c := newTestAdminController()
+
+	require.Nil(t, c.SetTierFees([]encoding.TierFee{{Tier: encoding.TierSgxID, Fee: big.NewInt(300)}}))
+	require.Equal(t, []encoding.TierFee{
+		{Tier: encoding.TierOptimisticID, Fee: big.NewInt(100)},
+		{Tier: encoding.TierSgxID, Fee: big.NewInt(300)},
+	}, c.TierFees())
+
+	// Invalid tier fees change nothing.
+	require.NotNil(t, c.SetTierFees([]encoding.TierFee{
+		{Tier: encoding.TierOptimisticID, Fee: big.NewInt(1)},
+		{Tier: 400, Fee: big.NewInt(1)},
+	}))
+	require.NotNil(t, c.SetTierFees([]encoding.TierFee{{Tier: encoding.TierSgxID, Fee: big.NewInt(-1)}}))
+	require.NotNil(t, c.SetTierFees([]encoding.TierFee{{Tier: encoding.TierSgxID}}))
+	require.Equal(t, big.NewInt(100), c.TierFees()[0].Fee)
+	require.Equal(t, big.NewInt(300), c.TierFees()[1].Fee)
+
+	// The returned tier fees are copies.
+	c.TierFees()[0].Fee.SetUint64(0)
+	require.Equal(t, big.NewInt(100), c.TierFees()[0].Fee)
+}
+
+func TestAdminControllerForcePropose(t *testing.T) {
+	c := newTestAdminController()
+
+	require.True(t, c.ForcePropose())
+	require.False(t, c.ForcePropose())
+	<-c.p.forceProposeCh
+	require.True(t, c.ForcePropose())
+}
+
+func TestAdminControllerConfigs(t *testing.T) {
+	c := newTestAdminController()
+
+	c.SetPaused(true)
+	require.True(t, c.Paused())
+
+	c.SetProposeInterval(time.Second)
+	require.Equal(t, time.Second, c.ProposeInterval())
+
+	c.SetMinGasUsed(1000)
+	require.Equal(t, uint64(1000), c.MinGasUsed())
+
+	require.Nil(t, c.LastProposal())
+	c.p.recordProposal(common.HexToHash("0x1"), 2, nil)
+	require.Equal(t, common.HexToHash("0x1").Hex(), c.LastProposal().TxHash)
+	require.Equal(t, uint(2), c.LastProposal().Txs)
+	require.Empty(t, c.LastProposal().Error)
+}
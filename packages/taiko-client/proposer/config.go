@@ -34,16 +34,26 @@ type Config struct {
 	MinTxListBytes             uint64
 	MinProposingInternal       time.Duration
 	MaxProposedTxListsPerEpoch uint64
+	TxPoolOrdering             string
+	ProfitAware                bool
+	ProposeBlockGas            uint64
+	BaseFeeSharingPctg         uint64
 	ProposeBlockTxGasLimit     uint64
 	ProverEndpoints            []*url.URL
 	OptimisticTierFee          *big.Int
 	SgxTierFee                 *big.Int
+	SgxAndZkVMTierFee          *big.Int
 	TierFeePriceBump           *big.Int
 	MaxTierFeePriceBumps       uint64
 	IncludeParentMetaHash      bool
 	BlobAllowed                bool
+	BlobCostAware              bool
+	BlobSwitchThreshold        uint64
 	TxmgrConfigs               *txmgr.CLIConfig
 	L1BlockBuilderTip          *big.Int
+	TxListCodec                utils.TxListCodec
+	AdminServerPort            uint64
+	AdminToken                 string
 }
 
 // NewConfigFromCliContext initializes a Config instance from
@@ -93,6 +103,29 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, err
 	}
 
+	sgxAndZkVMTierFee, err := utils.GWeiToWei(c.Float64(flags.SgxAndZkVMTierFee.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	txListCodec, err := utils.TxListCodecByName(c.String(flags.TxListCompression.Name))
+	if err != nil {
+		return nil, err
+	}
+	// Drivers and provers derive the blocks of other codecs as empty, until a protocol fork activates them.
+	if txListCodec.Name() != utils.CodecZlib {
+		return nil, fmt.Errorf(
+			"--%s %s is not activated by the protocol yet, only %s is supported",
+			flags.TxListCompression.Name,
+			txListCodec.Name(),
+			utils.CodecZlib,
+		)
+	}
+
+	if c.Uint64(flags.AdminServerPort.Name) != 0 && c.String(flags.AdminToken.Name) == "" {
+		return nil, fmt.Errorf("--%s is required by --%s", flags.AdminToken.Name, flags.AdminServerPort.Name)
+	}
+
 	return &Config{
 		ClientConfig: &rpc.ClientConfig{
 			L1Endpoint:        c.String(flags.L1WSEndpoint.Name),
@@ -115,15 +148,25 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		MinTxListBytes:             c.Uint64(flags.MinTxListBytes.Name),
 		MinProposingInternal:       c.Duration(flags.MinProposingInternal.Name),
 		MaxProposedTxListsPerEpoch: c.Uint64(flags.MaxProposedTxListsPerEpoch.Name),
+		TxPoolOrdering:             c.String(flags.TxPoolOrdering.Name),
+		ProfitAware:                c.Bool(flags.ProfitAware.Name),
+		ProposeBlockGas:            c.Uint64(flags.ProposeBlockGas.Name),
+		BaseFeeSharingPctg:         c.Uint64(flags.BaseFeeSharingPctg.Name),
 		ProposeBlockTxGasLimit:     c.Uint64(flags.TxGasLimit.Name),
 		ProverEndpoints:            proverEndpoints,
 		OptimisticTierFee:          optimisticTierFee,
 		SgxTierFee:                 sgxTierFee,
+		SgxAndZkVMTierFee:          sgxAndZkVMTierFee,
 		TierFeePriceBump:           new(big.Int).SetUint64(c.Uint64(flags.TierFeePriceBump.Name)),
 		MaxTierFeePriceBumps:       c.Uint64(flags.MaxTierFeePriceBumps.Name),
 		IncludeParentMetaHash:      c.Bool(flags.ProposeBlockIncludeParentMetaHash.Name),
 		BlobAllowed:                c.Bool(flags.BlobAllowed.Name),
+		BlobCostAware:              c.Bool(flags.BlobCostAware.Name),
+		BlobSwitchThreshold:        c.Uint64(flags.BlobSwitchThreshold.Name),
 		L1BlockBuilderTip:          new(big.Int).SetUint64(c.Uint64(flags.L1BlockBuilderTip.Name)),
+		TxListCodec:                txListCodec,
+		AdminServerPort:            c.Uint64(flags.AdminServerPort.Name),
+		AdminToken:                 c.String(flags.AdminToken.Name),
 		TxmgrConfigs: pkgFlags.InitTxmgrConfigsFromCli(
 			c.String(flags.L1WSEndpoint.Name),
 			l1ProposerPrivKey,
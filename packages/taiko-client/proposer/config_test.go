@@ -52,6 +52,7 @@ func (s *ProposerTestSuite) TestNewConfigFromCliContext() {
 		s.Nil(err)
 		s.Equal(tierFeeGWei.Uint64(), c.OptimisticTierFee.Uint64())
 		s.Equal(tierFeeGWei.Uint64(), c.SgxTierFee.Uint64())
+		s.Equal(tierFeeGWei.Uint64(), c.SgxAndZkVMTierFee.Uint64())
 		s.Equal(uint64(15), c.TierFeePriceBump.Uint64())
 		s.Equal(uint64(5), c.MaxTierFeePriceBumps)
 		s.Equal(true, c.IncludeParentMetaHash)
@@ -80,6 +81,7 @@ func (s *ProposerTestSuite) TestNewConfigFromCliContext() {
 		"--" + flags.ProverEndpoints.Name, proverEndpoints,
 		"--" + flags.OptimisticTierFee.Name, fmt.Sprint(tierFee),
 		"--" + flags.SgxTierFee.Name, fmt.Sprint(tierFee),
+		"--" + flags.SgxAndZkVMTierFee.Name, fmt.Sprint(tierFee),
 		"--" + flags.TierFeePriceBump.Name, "15",
 		"--" + flags.MaxTierFeePriceBumps.Name, "5",
 		"--" + flags.ProposeBlockIncludeParentMetaHash.Name, "true",
@@ -123,6 +125,20 @@ func (s *ProposerTestSuite) TestNewConfigFromCliContextTxPoolLocalsErr() {
 	}), "invalid account in --txpool.locals")
 }
 
+func (s *ProposerTestSuite) TestNewConfigFromCliContextTxListCompressionErr() {
+	goldenTouchAddress, err := s.RPCClient.TaikoL2.GOLDENTOUCHADDRESS(nil)
+	s.Nil(err)
+
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextTxListCompressionErr",
+		"--" + flags.L1ProposerPrivKey.Name, encoding.GoldenTouchPrivKey,
+		"--" + flags.L2SuggestedFeeRecipient.Name, goldenTouchAddress.Hex(),
+		"--" + flags.TxListCompression.Name, "zstd",
+	}), "--txList.compression zstd is not activated by the protocol yet")
+}
+
 func (s *ProposerTestSuite) SetupApp() *cli.App {
 	app := cli.NewApp()
 	app.Flags = []cli.Flag{
@@ -139,10 +155,12 @@ func (s *ProposerTestSuite) SetupApp() *cli.App {
 		&cli.StringFlag{Name: flags.ProverEndpoints.Name},
 		&cli.Uint64Flag{Name: flags.OptimisticTierFee.Name},
 		&cli.Uint64Flag{Name: flags.SgxTierFee.Name},
+		&cli.Uint64Flag{Name: flags.SgxAndZkVMTierFee.Name},
 		&cli.DurationFlag{Name: flags.RPCTimeout.Name},
 		&cli.Uint64Flag{Name: flags.TierFeePriceBump.Name},
 		&cli.Uint64Flag{Name: flags.MaxTierFeePriceBumps.Name},
 		&cli.BoolFlag{Name: flags.ProposeBlockIncludeParentMetaHash.Name},
+		&cli.StringFlag{Name: flags.TxListCompression.Name, Value: flags.TxListCompression.Value},
 	}
 	app.Flags = append(app.Flags, flags.TxmgrFlags...)
 	app.Action = func(ctx *cli.Context) error {
@@ -3,8 +3,10 @@ package proposer
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
+	"net/http"
 	"sync"
 	"time"
 
@@ -24,7 +26,9 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
 	selector "github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/prover_selector"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/server"
 	builder "github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/transaction_builder"
+	txlistselector "github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/txlist_selector"
 )
 
 // Proposer keep proposing new transactions from L2 execution engine's tx pool at a fixed interval.
@@ -49,6 +53,9 @@ type Proposer struct {
 	// Transaction builder
 	txBuilder builder.ProposeBlockTransactionBuilder
 
+	// Transactions lists selector
+	txListSelector txlistselector.TxListSelector
+
 	// Protocol configurations
 	protocolConfigs *bindings.TaikoDataConfig
 
@@ -56,6 +63,14 @@ type Proposer struct {
 
 	txmgr *txmgr.SimpleTxManager
 
+	// Admin server, mutex guards the states which can be changed through it, including the
+	// proposing interval, minimum gas used and tier fees configurations.
+	server         *server.ProposerServer
+	mutex          sync.RWMutex
+	paused         bool
+	lastProposal   *server.ProposalResult
+	forceProposeCh chan struct{}
+
 	ctx context.Context
 	wg  sync.WaitGroup
 }
@@ -76,6 +91,11 @@ func (p *Proposer) InitFromConfig(ctx context.Context, cfg *Config, txMgr *txmgr
 	p.ctx = ctx
 	p.Config = cfg
 	p.lastProposedAt = time.Now()
+	p.forceProposeCh = make(chan struct{}, 1)
+
+	if p.TxListCodec == nil {
+		p.TxListCodec = utils.DefaultTxListCodec
+	}
 
 	// RPC clients
 	if p.rpc, err = rpc.NewClient(p.ctx, cfg.ClientConfig); err != nil {
@@ -125,8 +145,8 @@ func (p *Proposer) InitFromConfig(ctx context.Context, cfg *Config, txMgr *txmgr
 		return err
 	}
 
-	if cfg.BlobAllowed {
-		p.txBuilder = builder.NewBlobTransactionBuilder(
+	var (
+		blobTxBuilder = builder.NewBlobTransactionBuilder(
 			p.rpc,
 			p.L1ProposerPrivKey,
 			p.proverSelector,
@@ -137,8 +157,7 @@ func (p *Proposer) InitFromConfig(ctx context.Context, cfg *Config, txMgr *txmgr
 			cfg.ProposeBlockTxGasLimit,
 			cfg.ExtraData,
 		)
-	} else {
-		p.txBuilder = builder.NewCalldataTransactionBuilder(
+		calldataTxBuilder = builder.NewCalldataTransactionBuilder(
 			p.rpc,
 			p.L1ProposerPrivKey,
 			p.proverSelector,
@@ -149,6 +168,52 @@ func (p *Proposer) InitFromConfig(ctx context.Context, cfg *Config, txMgr *txmgr
 			cfg.ProposeBlockTxGasLimit,
 			cfg.ExtraData,
 		)
+	)
+
+	switch {
+	case cfg.BlobAllowed && cfg.BlobCostAware:
+		p.txBuilder = builder.NewCostAwareTransactionBuilder(
+			p.rpc,
+			blobTxBuilder,
+			calldataTxBuilder,
+			cfg.BlobSwitchThreshold,
+		)
+	case cfg.BlobAllowed:
+		p.txBuilder = blobTxBuilder
+	default:
+		p.txBuilder = calldataTxBuilder
+	}
+
+	ordering, err := txlistselector.NewOrderingPolicy(
+		cfg.TxPoolOrdering,
+		types.LatestSignerForChainID(p.rpc.L2.ChainID),
+		cfg.LocalAddresses,
+	)
+	if err != nil {
+		return err
+	}
+
+	if cfg.ProfitAware {
+		p.txListSelector = txlistselector.NewProfitAwareSelector(
+			p.rpc,
+			ordering,
+			p.TxListCodec,
+			cfg.ProposeBlockGas,
+			cfg.BaseFeeSharingPctg,
+			cfg.BlobAllowed,
+			!cfg.BlobAllowed || cfg.BlobCostAware,
+		)
+	} else {
+		p.txListSelector = txlistselector.NewDefaultSelector(p.rpc, ordering)
+	}
+
+	if cfg.AdminServerPort != 0 {
+		if p.server, err = server.New(&server.NewProposerServerOpts{
+			Controller: &adminController{p},
+			Token:      cfg.AdminToken,
+		}); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -156,6 +221,14 @@ func (p *Proposer) InitFromConfig(ctx context.Context, cfg *Config, txMgr *txmgr
 
 // Start starts the proposer's main loop.
 func (p *Proposer) Start() error {
+	if p.server != nil {
+		go func() {
+			if err := p.server.Start(fmt.Sprintf(":%v", p.AdminServerPort)); !errors.Is(err, http.ErrServerClosed) {
+				log.Crit("Failed to start admin server", "error", err)
+			}
+		}()
+	}
+
 	p.wg.Add(1)
 	go p.eventLoop()
 	return nil
@@ -176,11 +249,27 @@ func (p *Proposer) eventLoop() {
 			return
 		// proposing interval timer has been reached
 		case <-p.proposingTimer.C:
+			if p.isPaused() {
+				log.Info("Proposer paused, skip proposing")
+				continue
+			}
+
 			metrics.ProposerProposeEpochCounter.Add(1)
 
 			// Attempt a proposing operation
 			if err := p.ProposeOp(p.ctx); err != nil {
 				log.Error("Proposing operation error", "error", err)
+				p.recordProposal(common.Hash{}, 0, err)
+				continue
+			}
+		// a proposing operation has been forced through the admin server
+		case <-p.forceProposeCh:
+			metrics.ProposerProposeEpochCounter.Add(1)
+
+			log.Info("Forced proposing operation")
+			if err := p.proposeOp(p.ctx, true); err != nil {
+				log.Error("Forced proposing operation error", "error", err)
+				p.recordProposal(common.Hash{}, 0, err)
 				continue
 			}
 		}
@@ -188,7 +277,12 @@ func (p *Proposer) eventLoop() {
 }
 
 // Close closes the proposer instance.
-func (p *Proposer) Close(_ context.Context) {
+func (p *Proposer) Close(ctx context.Context) {
+	if p.server != nil {
+		if err := p.server.Shutdown(ctx); err != nil {
+			log.Error("Failed to shut down admin server", "error", err)
+		}
+	}
 	p.wg.Wait()
 }
 
@@ -207,15 +301,19 @@ func (p *Proposer) fetchPoolContent(filterPoolContent bool) ([]types.Transaction
 		return nil, fmt.Errorf("failed to fetch transaction pool content: %w", err)
 	}
 
+	p.mutex.RLock()
+	minGasUsed := p.MinGasUsed
+	p.mutex.RUnlock()
+
 	txLists := []types.Transactions{}
 	for i, txs := range preBuiltTxList {
 		// Filter the pool content if the filterPoolContent flag is set.
-		if txs.EstimatedGasUsed < p.MinGasUsed && txs.BytesLength < p.MinTxListBytes && filterPoolContent {
+		if txs.EstimatedGasUsed < minGasUsed && txs.BytesLength < p.MinTxListBytes && filterPoolContent {
 			log.Info(
 				"Pool content skipped",
 				"index", i,
 				"estimatedGasUsed", txs.EstimatedGasUsed,
-				"minGasUsed", p.MinGasUsed,
+				"minGasUsed", minGasUsed,
 				"bytesLength", txs.BytesLength,
 				"minBytesLength", p.MinTxListBytes,
 			)
@@ -270,8 +368,14 @@ func (p *Proposer) fetchPoolContent(filterPoolContent bool) ([]types.Transaction
 // from L2 execution engine's tx pool, splitting them by proposing constraints,
 // and then proposing them to TaikoL1 contract.
 func (p *Proposer) ProposeOp(ctx context.Context) error {
+	return p.proposeOp(ctx, false)
+}
+
+// proposeOp performs a proposing operation, if force is set, the pool content is proposed
+// unfiltered, as if the minimum proposing interval has passed.
+func (p *Proposer) proposeOp(ctx context.Context, force bool) error {
 	// Check if it's time to propose unfiltered pool content.
-	filterPoolContent := time.Now().Before(p.lastProposedAt.Add(p.MinProposingInternal))
+	filterPoolContent := !force && time.Now().Before(p.lastProposedAt.Add(p.MinProposingInternal))
 
 	// Wait until L2 execution engine is synced at first.
 	if err := p.rpc.WaitTillL2ExecutionEngineSynced(ctx); err != nil {
@@ -294,6 +398,15 @@ func (p *Proposer) ProposeOp(ctx context.Context) error {
 		return nil
 	}
 
+	if txLists, err = p.txListSelector.Select(ctx, txLists, !filterPoolContent); err != nil {
+		return fmt.Errorf("failed to select transactions lists: %w", err)
+	}
+
+	// If no transactions list is worth proposing, return.
+	if len(txLists) == 0 {
+		return nil
+	}
+
 	g, gCtx := errgroup.WithContext(ctx)
 	// Propose all L2 transactions lists.
 	for _, txs := range txLists[:utils.Min(p.MaxProposedTxListsPerEpoch, uint64(len(txLists)))] {
@@ -334,14 +447,14 @@ func (p *Proposer) ProposeTxList(
 	txListBytes []byte,
 	txNum uint,
 ) error {
-	compressedTxListBytes, err := utils.Compress(txListBytes)
+	compressedTxListBytes, err := p.TxListCodec.Compress(txListBytes)
 	if err != nil {
 		return err
 	}
 
 	txCandidate, err := p.txBuilder.Build(
 		ctx,
-		p.tierFees,
+		p.currentTierFees(),
 		p.IncludeParentMetaHash,
 		compressedTxListBytes,
 	)
@@ -361,6 +474,7 @@ func (p *Proposer) ProposeTxList(
 	}
 
 	log.Info("📝 Propose transactions succeeded", "txs", txNum)
+	p.recordProposal(receipt.TxHash, txNum, nil)
 
 	metrics.ProposerProposedTxListsCounter.Add(1)
 	metrics.ProposerProposedTxsCounter.Add(float64(txNum))
@@ -374,10 +488,11 @@ func (p *Proposer) updateProposingTicker() {
 		p.proposingTimer.Stop()
 	}
 
-	var duration time.Duration
-	if p.ProposeInterval != 0 {
-		duration = p.ProposeInterval
-	} else {
+	p.mutex.RLock()
+	duration := p.ProposeInterval
+	p.mutex.RUnlock()
+
+	if duration == 0 {
 		// Random number between 12 - 120
 		randomSeconds := rand.Intn(120-11) + 12 // nolint: gosec
 		duration = time.Duration(randomSeconds) * time.Second
@@ -409,6 +524,8 @@ func (p *Proposer) initTierFees() error {
 			p.tierFees = append(p.tierFees, encoding.TierFee{Tier: tier.ID, Fee: p.OptimisticTierFee})
 		case encoding.TierSgxID:
 			p.tierFees = append(p.tierFees, encoding.TierFee{Tier: tier.ID, Fee: p.SgxTierFee})
+		case encoding.TierSgxAndZkVMID:
+			p.tierFees = append(p.tierFees, encoding.TierFee{Tier: tier.ID, Fee: p.SgxAndZkVMTierFee})
 		case encoding.TierGuardianMinorityID:
 			p.tierFees = append(p.tierFees, encoding.TierFee{Tier: tier.ID, Fee: common.Big0})
 		case encoding.TierGuardianMajorityID:
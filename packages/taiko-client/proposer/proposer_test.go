@@ -81,6 +81,7 @@ func (s *ProposerTestSuite) SetupTest() {
 		ProverEndpoints:            s.ProverEndpoints,
 		OptimisticTierFee:          common.Big256,
 		SgxTierFee:                 common.Big256,
+		SgxAndZkVMTierFee:          common.Big256,
 		TierFeePriceBump:           common.Big2,
 		MaxTierFeePriceBumps:       3,
 		ExtraData:                  "test",
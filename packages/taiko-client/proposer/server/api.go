@@ -0,0 +1,136 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+)
+
+// Status represents the current proposer status.
+type Status struct {
+	Paused          bool               `json:"paused"`
+	ProposeInterval string             `json:"proposeInterval"`
+	MinGasUsed      uint64             `json:"minGasUsed"`
+	TierFees        []encoding.TierFee `json:"tierFees"`
+	LastProposal    *ProposalResult    `json:"lastProposal"`
+	PendingNonce    uint64             `json:"pendingNonce"`
+}
+
+// GetStatus handles a query to the current proposer status.
+//
+//	@Summary		Get current proposer status
+//	@ID			   	get-status
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object} Status
+//	@Failure		500	{string} string	"failed to get pending nonce"
+//	@Router			/status [get]
+func (s *ProposerServer) GetStatus(c echo.Context) error {
+	nonce, err := s.controller.PendingNonce(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	return c.JSON(http.StatusOK, &Status{
+		Paused:          s.controller.Paused(),
+		ProposeInterval: s.controller.ProposeInterval().String(),
+		MinGasUsed:      s.controller.MinGasUsed(),
+		TierFees:        s.controller.TierFees(),
+		LastProposal:    s.controller.LastProposal(),
+		PendingNonce:    nonce,
+	})
+}
+
+// Pause handles a request to pause the proposing loop.
+//
+//	@Summary		Pause proposing
+//	@ID			   	pause
+//	@Success		204
+//	@Router			/pause [post]
+func (s *ProposerServer) Pause(c echo.Context) error {
+	s.controller.SetPaused(true)
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Resume handles a request to resume the proposing loop.
+//
+//	@Summary		Resume proposing
+//	@ID			   	resume
+//	@Success		204
+//	@Router			/resume [post]
+func (s *ProposerServer) Resume(c echo.Context) error {
+	s.controller.SetPaused(false)
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Propose handles a request to propose now, without waiting for the proposing interval.
+//
+//	@Summary		Force a proposing operation
+//	@ID			   	propose
+//	@Success		202
+//	@Failure		409	{string} string	"proposer paused"
+//	@Failure		409	{string} string	"proposing operation already pending"
+//	@Router			/propose [post]
+func (s *ProposerServer) Propose(c echo.Context) error {
+	if s.controller.Paused() {
+		return echo.NewHTTPError(http.StatusConflict, "proposer paused")
+	}
+
+	if !s.controller.ForcePropose() {
+		return echo.NewHTTPError(http.StatusConflict, "proposing operation already pending")
+	}
+
+	return c.NoContent(http.StatusAccepted)
+}
+
+// UpdateConfigRequestBody represents a request body when changing the proposer configurations,
+// fields which are not set are left unchanged.
+type UpdateConfigRequestBody struct {
+	ProposeInterval *string            `json:"proposeInterval"`
+	MinGasUsed      *uint64            `json:"minGasUsed"`
+	TierFees        []encoding.TierFee `json:"tierFees"`
+}
+
+// UpdateConfig handles a request to change the proposer configurations at runtime.
+//
+//	@Summary		Change proposer configurations
+//	@ID			   	update-config
+//	@Param          body	body	server.UpdateConfigRequestBody   true    "configurations to change"
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object} Status
+//	@Failure		400	{string} string	"invalid propose interval"
+//	@Failure		422	{string} string	"invalid tier fees"
+//	@Router			/config [patch]
+func (s *ProposerServer) UpdateConfig(c echo.Context) error {
+	req := new(UpdateConfigRequestBody)
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	var interval time.Duration
+	if req.ProposeInterval != nil {
+		var err error
+		if interval, err = time.ParseDuration(*req.ProposeInterval); err != nil || interval < 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid propose interval")
+		}
+	}
+
+	// Validate the tier fees first, so that an invalid request changes nothing.
+	if req.TierFees != nil {
+		if err := s.controller.SetTierFees(req.TierFees); err != nil {
+			return echo.NewHTTPError(http.StatusUnprocessableEntity, err.Error())
+		}
+	}
+	if req.ProposeInterval != nil {
+		s.controller.SetProposeInterval(interval)
+	}
+	if req.MinGasUsed != nil {
+		s.controller.SetMinGasUsed(*req.MinGasUsed)
+	}
+
+	return s.GetStatus(c)
+}
@@ -0,0 +1,146 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+)
+
+var errEmptyAdminToken = errors.New("empty admin API token")
+
+// ProposalResult is the result of the latest proposing operation.
+type ProposalResult struct {
+	Time   time.Time `json:"time"`
+	TxHash string    `json:"txHash,omitempty"`
+	Txs    uint      `json:"txs"`
+	Error  string    `json:"error,omitempty"`
+}
+
+// Controller is the proposer state the admin server inspects and steers.
+type Controller interface {
+	// Paused returns whether the proposing loop is paused.
+	Paused() bool
+	// SetPaused pauses or resumes the proposing loop.
+	SetPaused(paused bool)
+	// ForcePropose triggers a proposing operation without waiting for the proposing interval, it
+	// returns false if an operation is already pending.
+	ForcePropose() bool
+	// TierFees returns the current proving fee of every proof tier.
+	TierFees() []encoding.TierFee
+	// SetTierFees replaces the proving fees of the given proof tiers.
+	SetTierFees(tierFees []encoding.TierFee) error
+	// ProposeInterval returns the current proposing interval.
+	ProposeInterval() time.Duration
+	// SetProposeInterval changes the proposing interval, starting from the next epoch.
+	SetProposeInterval(interval time.Duration)
+	// MinGasUsed returns the current minimum gas used of a proposed transactions list.
+	MinGasUsed() uint64
+	// SetMinGasUsed changes the minimum gas used of a proposed transactions list.
+	SetMinGasUsed(minGasUsed uint64)
+	// LastProposal returns the result of the latest proposing operation, or nil.
+	LastProposal() *ProposalResult
+	// PendingNonce returns the proposer's pending L1 nonce.
+	PendingNonce(ctx context.Context) (uint64, error)
+}
+
+// @title Taiko Proposer Admin API
+// @version 1.0
+// @termsOfService http://swagger.io/terms/
+
+// @contact.name API Support
+// @contact.url https://community.taiko.xyz/
+// @contact.email info@taiko.xyz
+
+// @license.name MIT
+// @license.url https://github.com/taikoxyz/taiko-mono/blob/main/LICENSE.md
+// ProposerServer represents a proposer admin server instance.
+type ProposerServer struct {
+	echo       *echo.Echo
+	controller Controller
+	token      string
+}
+
+// NewProposerServerOpts contains all configurations for creating a proposer admin server instance.
+type NewProposerServerOpts struct {
+	Controller Controller
+	// Token is the bearer token every request, except the health checks, must carry.
+	Token string
+}
+
+// New creates a new proposer admin server instance.
+func New(opts *NewProposerServerOpts) (*ProposerServer, error) {
+	if opts.Token == "" {
+		return nil, errEmptyAdminToken
+	}
+
+	srv := &ProposerServer{
+		echo:       echo.New(),
+		controller: opts.Controller,
+		token:      opts.Token,
+	}
+
+	srv.echo.HideBanner = true
+	srv.configureMiddleware()
+	srv.configureRoutes()
+
+	return srv, nil
+}
+
+// Start starts the HTTP server.
+func (s *ProposerServer) Start(address string) error {
+	return s.echo.Start(address)
+}
+
+// Shutdown shuts down the HTTP server.
+func (s *ProposerServer) Shutdown(ctx context.Context) error {
+	return s.echo.Shutdown(ctx)
+}
+
+// Health endpoints for probes.
+func (s *ProposerServer) Health(c echo.Context) error {
+	return c.NoContent(http.StatusOK)
+}
+
+// healthSkipper implements the `middleware.Skipper` interface.
+func healthSkipper(c echo.Context) bool {
+	return c.Request().URL.Path == "/healthz"
+}
+
+// configureMiddleware configures the server middlewares.
+func (s *ProposerServer) configureMiddleware() {
+	s.echo.Use(middleware.RequestID())
+
+	s.echo.Use(middleware.LoggerWithConfig(middleware.LoggerConfig{
+		Skipper: healthSkipper,
+		Format: `{"time":"${time_rfc3339_nano}","level":"INFO","message":{"id":"${id}","remote_ip":"${remote_ip}",` +
+			`"host":"${host}","method":"${method}","uri":"${uri}","user_agent":"${user_agent}",` +
+			`"response_status":${status},"error":"${error}","latency":${latency},"latency_human":"${latency_human}",` +
+			`"bytes_in":${bytes_in},"bytes_out":${bytes_out}}}` + "\n",
+		Output: os.Stdout,
+	}))
+
+	s.echo.Use(middleware.KeyAuthWithConfig(middleware.KeyAuthConfig{
+		Skipper: healthSkipper,
+		Validator: func(key string, _ echo.Context) (bool, error) {
+			return subtle.ConstantTimeCompare([]byte(key), []byte(s.token)) == 1, nil
+		},
+	}))
+}
+
+// configureRoutes contains all routes which will be used by proposer admin server.
+func (s *ProposerServer) configureRoutes() {
+	s.echo.GET("/healthz", s.Health)
+	s.echo.GET("/status", s.GetStatus)
+	s.echo.POST("/pause", s.Pause)
+	s.echo.POST("/resume", s.Resume)
+	s.echo.POST("/propose", s.Propose)
+	s.echo.PATCH("/config", s.UpdateConfig)
+}
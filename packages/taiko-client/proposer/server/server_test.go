@@ -0,0 +1,175 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+)
+
+const testToken = "test-token"
+
+type fakeController struct {
+	paused       bool
+	forced       bool
+	tierFees     []encoding.TierFee
+	interval     time.Duration
+	minGasUsed   uint64
+	lastProposal *ProposalResult
+}
+
+func (c *fakeController) Paused() bool          { return c.paused }
+func (c *fakeController) SetPaused(paused bool) { c.paused = paused }
+func (c *fakeController) ForcePropose() bool {
+	if c.forced {
+		return false
+	}
+	c.forced = true
+	return true
+}
+func (c *fakeController) TierFees() []encoding.TierFee { return c.tierFees }
+func (c *fakeController) SetTierFees(tierFees []encoding.TierFee) error {
+	for _, tierFee := range tierFees {
+		if tierFee.Tier != encoding.TierOptimisticID {
+			return errors.New("unknown tier")
+		}
+	}
+	c.tierFees = tierFees
+	return nil
+}
+func (c *fakeController) ProposeInterval() time.Duration                 { return c.interval }
+func (c *fakeController) SetProposeInterval(interval time.Duration)      { c.interval = interval }
+func (c *fakeController) MinGasUsed() uint64                             { return c.minGasUsed }
+func (c *fakeController) SetMinGasUsed(minGasUsed uint64)                { c.minGasUsed = minGasUsed }
+func (c *fakeController) LastProposal() *ProposalResult                  { return c.lastProposal }
+func (c *fakeController) PendingNonce(_ context.Context) (uint64, error) { return 7, nil }
+
+func newTestServer(t *testing.T) (*fakeController, *httptest.Server) {
+	controller := &fakeController{
+		tierFees: []encoding.TierFee{{Tier: encoding.TierOptimisticID, Fee: big.NewInt(100)}},
+		interval: time.Minute,
+	}
+
+	s, err := New(&NewProposerServerOpts{Controller: controller, Token: testToken})
+	require.Nil(t, err)
+
+	srv := httptest.NewServer(s.echo)
+	t.Cleanup(srv.Close)
+
+	return controller, srv
+}
+
+func sendReq(t *testing.T, srv *httptest.Server, method, path, token, body string) *http.Response {
+	req, err := http.NewRequest(method, srv.URL+path, strings.NewReader(body))
+	require.Nil(t, err)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.Nil(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+
+	return resp
+}
+
+func TestNewEmptyToken(t *testing.T) {
+	_, err := New(&NewProposerServerOpts{Controller: &fakeController{}})
+	require.ErrorIs(t, err, errEmptyAdminToken)
+}
+
+func TestAuth(t *testing.T) {
+	_, srv := newTestServer(t)
+
+	require.Equal(t, http.StatusOK, sendReq(t, srv, http.MethodGet, "/healthz", "", "").StatusCode)
+	require.Equal(t, http.StatusBadRequest, sendReq(t, srv, http.MethodGet, "/status", "", "").StatusCode)
+	require.Equal(t, http.StatusUnauthorized, sendReq(t, srv, http.MethodGet, "/status", "wrong", "").StatusCode)
+	require.Equal(t, http.StatusOK, sendReq(t, srv, http.MethodGet, "/status", testToken, "").StatusCode)
+}
+
+func TestGetStatus(t *testing.T) {
+	controller, srv := newTestServer(t)
+	controller.lastProposal = &ProposalResult{TxHash: "0x1", Txs: 2}
+
+	resp := sendReq(t, srv, http.MethodGet, "/status", testToken, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	status := new(Status)
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(status))
+	require.False(t, status.Paused)
+	require.Equal(t, "1m0s", status.ProposeInterval)
+	require.Equal(t, uint64(7), status.PendingNonce)
+	require.Equal(t, controller.tierFees, status.TierFees)
+	require.Equal(t, "0x1", status.LastProposal.TxHash)
+}
+
+func TestPauseResumePropose(t *testing.T) {
+	controller, srv := newTestServer(t)
+
+	require.Equal(t, http.StatusNoContent, sendReq(t, srv, http.MethodPost, "/pause", testToken, "").StatusCode)
+	require.True(t, controller.paused)
+	require.Equal(t, http.StatusConflict, sendReq(t, srv, http.MethodPost, "/propose", testToken, "").StatusCode)
+	require.False(t, controller.forced)
+
+	require.Equal(t, http.StatusNoContent, sendReq(t, srv, http.MethodPost, "/resume", testToken, "").StatusCode)
+	require.False(t, controller.paused)
+	require.Equal(t, http.StatusAccepted, sendReq(t, srv, http.MethodPost, "/propose", testToken, "").StatusCode)
+	require.True(t, controller.forced)
+	// A forced proposing operation is already pending.
+	require.Equal(t, http.StatusConflict, sendReq(t, srv, http.MethodPost, "/propose", testToken, "").StatusCode)
+}
+
+func TestUpdateConfig(t *testing.T) {
+	controller, srv := newTestServer(t)
+
+	resp := sendReq(
+		t,
+		srv,
+		http.MethodPatch,
+		"/config",
+		testToken,
+		`{"proposeInterval":"30s","minGasUsed":1000,"tierFees":[{"Tier":100,"Fee":200}]}`,
+	)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, 30*time.Second, controller.interval)
+	require.Equal(t, uint64(1000), controller.minGasUsed)
+	require.Equal(t, big.NewInt(200), controller.tierFees[0].Fee)
+
+	// Fields which are not set are left unchanged.
+	resp = sendReq(t, srv, http.MethodPatch, "/config", testToken, `{"minGasUsed":2000}`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, 30*time.Second, controller.interval)
+	require.Equal(t, uint64(2000), controller.minGasUsed)
+}
+
+func TestUpdateConfigInvalid(t *testing.T) {
+	controller, srv := newTestServer(t)
+
+	resp := sendReq(t, srv, http.MethodPatch, "/config", testToken, `{"proposeInterval":"soon"}`)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	// An invalid request changes nothing.
+	resp = sendReq(
+		t,
+		srv,
+		http.MethodPatch,
+		"/config",
+		testToken,
+		`{"minGasUsed":1000,"tierFees":[{"Tier":400,"Fee":200}]}`,
+	)
+	require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	require.Zero(t, controller.minGasUsed)
+	require.Equal(t, time.Minute, controller.interval)
+}
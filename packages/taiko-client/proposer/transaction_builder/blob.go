@@ -19,6 +19,10 @@ import (
 
 // BlobTransactionBuilder is responsible for building a TaikoL1.proposeBlock transaction with txList
 // bytes saved in blob.
+//
+// Each transaction carries exactly one blob: TaikoL1 always reads a block's txList from the first
+// blob of the proposing transaction (`blobhash(0)`), and blocks proposed in the same transaction
+// share that blob, so extra blobs would never be read, and a txList can not span several blobs.
 type BlobTransactionBuilder struct {
 	rpc                     *rpc.Client
 	proposerPrivateKey      *ecdsa.PrivateKey
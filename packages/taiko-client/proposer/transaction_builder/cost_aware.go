@@ -0,0 +1,168 @@
+package builder
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+const (
+	// Calldata pricing since EIP-7623, where a zero byte counts as one token and a non-zero byte as four.
+	calldataTokensPerNonZeroByte uint64 = 4
+	calldataStandardCostPerToken uint64 = 4
+	calldataFloorCostPerToken    uint64 = 10
+	// proposeBlockExecutionGas is a lower bound of the gas a TaikoL1.proposeBlock call spends on
+	// execution, which is what the calldata floor is compared against.
+	proposeBlockExecutionGas uint64 = 150_000
+)
+
+// CostAwareTransactionBuilder is responsible for building a TaikoL1.proposeBlock transaction with txList
+// bytes saved in either blob or calldata, whichever is estimated to be cheaper for each proposal.
+type CostAwareTransactionBuilder struct {
+	rpc             *rpc.Client
+	blobBuilder     *BlobTransactionBuilder
+	calldataBuilder *CalldataTransactionBuilder
+	// switchThreshold is the percentage the other encoding must be cheaper by than the current one,
+	// before the builder switches to it, so it does not flip back and forth on small fee changes.
+	switchThreshold uint64
+
+	mu      sync.Mutex
+	useBlob bool
+}
+
+// NewCostAwareTransactionBuilder creates a new CostAwareTransactionBuilder instance based on giving
+// configurations. It starts out proposing with blobs.
+func NewCostAwareTransactionBuilder(
+	rpc *rpc.Client,
+	blobBuilder *BlobTransactionBuilder,
+	calldataBuilder *CalldataTransactionBuilder,
+	switchThreshold uint64,
+) *CostAwareTransactionBuilder {
+	return &CostAwareTransactionBuilder{
+		rpc:             rpc,
+		blobBuilder:     blobBuilder,
+		calldataBuilder: calldataBuilder,
+		switchThreshold: switchThreshold,
+		useBlob:         true,
+	}
+}
+
+// Build implements the ProposeBlockTransactionBuilder interface.
+func (b *CostAwareTransactionBuilder) Build(
+	ctx context.Context,
+	tierFees []encoding.TierFee,
+	includeParentMetaHash bool,
+	txListBytes []byte,
+) (*txmgr.TxCandidate, error) {
+	head, err := b.rpc.L1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	gasTipCap, err := b.rpc.L1.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Blobs are not available before Cancun.
+	if head.ExcessBlobGas == nil {
+		metrics.ProposerCalldataTxListsCounter.Add(1)
+		return b.calldataBuilder.Build(ctx, tierFees, includeParentMetaHash, txListBytes)
+	}
+
+	var (
+		gasPrice     = new(big.Int).Add(head.BaseFee, gasTipCap)
+		blobCost     = EstimateBlobCost(eip4844.CalcBlobFee(*head.ExcessBlobGas))
+		calldataCost = EstimateCalldataCost(txListBytes, gasPrice)
+		useBlob      = b.chooseBlob(blobCost, calldataCost)
+	)
+
+	blobCostFloat, _ := new(big.Float).SetInt(blobCost).Float64()
+	calldataCostFloat, _ := new(big.Float).SetInt(calldataCost).Float64()
+	metrics.ProposerEstimatedBlobCostGauge.Set(blobCostFloat)
+	metrics.ProposerEstimatedCalldataCostGauge.Set(calldataCostFloat)
+
+	log.Info(
+		"Estimated txList publishing costs",
+		"blobCost", blobCost,
+		"calldataCost", calldataCost,
+		"txListBytes", len(txListBytes),
+		"useBlob", useBlob,
+	)
+
+	if useBlob {
+		metrics.ProposerBlobTxListsCounter.Add(1)
+		return b.blobBuilder.Build(ctx, tierFees, includeParentMetaHash, txListBytes)
+	}
+
+	metrics.ProposerCalldataTxListsCounter.Add(1)
+	return b.calldataBuilder.Build(ctx, tierFees, includeParentMetaHash, txListBytes)
+}
+
+// chooseBlob returns whether to propose with a blob given the estimated costs of both encodings,
+// only switching away from the current encoding when the other one is cheaper by more than
+// switchThreshold percent.
+func (b *CostAwareTransactionBuilder) chooseBlob(blobCost *big.Int, calldataCost *big.Int) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	current, other := calldataCost, blobCost
+	if b.useBlob {
+		current, other = blobCost, calldataCost
+	}
+
+	// other * (100 + switchThreshold) < current * 100
+	if new(big.Int).Mul(other, new(big.Int).SetUint64(100+b.switchThreshold)).Cmp(
+		new(big.Int).Mul(current, big.NewInt(100)),
+	) < 0 {
+		b.useBlob = !b.useBlob
+	}
+
+	return b.useBlob
+}
+
+// EstimateBlobCost returns the cost of publishing a txList in a blob, which always takes up a whole
+// blob, whatever the size of the txList.
+func EstimateBlobCost(blobBaseFee *big.Int) *big.Int {
+	return new(big.Int).Mul(new(big.Int).SetUint64(params.BlobTxBlobGasPerBlob), blobBaseFee)
+}
+
+// EstimateCalldataCost returns the cost of publishing a txList in calldata, the ABI encoded txList
+// bytes priced as transaction data. The rest of the transaction costs the same for both encodings.
+//
+// Since EIP-7623, a transaction pays at least calldataFloorCostPerToken gas for each calldata token,
+// instead of its execution gas plus calldataStandardCostPerToken per token, whenever the floor is
+// higher, which it is for all but the smallest txLists.
+func EstimateCalldataCost(txListBytes []byte, gasPrice *big.Int) *big.Int {
+	var tokens uint64
+	for _, b := range txListBytes {
+		if b == 0 {
+			tokens++
+		} else {
+			tokens += calldataTokensPerNonZeroByte
+		}
+	}
+
+	// The length word, which is mostly zero bytes, and the zero padding up to a whole word.
+	tokens += 32
+	if remainder := len(txListBytes) % 32; remainder != 0 {
+		tokens += uint64(32 - remainder)
+	}
+
+	// The txList adds max(standard + execution, floor) - execution gas to the transaction.
+	gas := tokens * calldataStandardCostPerToken
+	if floor := tokens * calldataFloorCostPerToken; floor > gas+proposeBlockExecutionGas {
+		gas = floor - proposeBlockExecutionGas
+	}
+
+	return new(big.Int).Mul(new(big.Int).SetUint64(gas), gasPrice)
+}
@@ -0,0 +1,70 @@
+package builder
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChooseBlobHysteresis(t *testing.T) {
+	b := NewCostAwareTransactionBuilder(nil, nil, nil, 10)
+
+	// Calldata is cheaper, but not by more than the threshold.
+	assert.True(t, b.chooseBlob(big.NewInt(100), big.NewInt(95)))
+	// Calldata is cheaper by more than the threshold.
+	assert.False(t, b.chooseBlob(big.NewInt(100), big.NewInt(80)))
+	// Blob is cheaper, but not by more than the threshold.
+	assert.False(t, b.chooseBlob(big.NewInt(95), big.NewInt(100)))
+	// Blob is cheaper by more than the threshold.
+	assert.True(t, b.chooseBlob(big.NewInt(80), big.NewInt(100)))
+}
+
+func TestChooseBlobNoThreshold(t *testing.T) {
+	b := NewCostAwareTransactionBuilder(nil, nil, nil, 0)
+
+	assert.True(t, b.chooseBlob(big.NewInt(100), big.NewInt(100)))
+	assert.False(t, b.chooseBlob(big.NewInt(100), big.NewInt(99)))
+	assert.True(t, b.chooseBlob(big.NewInt(99), big.NewInt(100)))
+}
+
+func TestEstimateBlobCost(t *testing.T) {
+	assert.Equal(
+		t,
+		new(big.Int).SetUint64(params.BlobTxBlobGasPerBlob*3),
+		EstimateBlobCost(big.NewInt(3)),
+	)
+}
+
+func TestEstimateCalldataCost(t *testing.T) {
+	// 32 length word zero bytes, plus 31 bytes of padding.
+	overhead := uint64(32 + 31)
+
+	// Small txLists are priced at the standard rate.
+	assert.Equal(
+		t,
+		new(big.Int).SetUint64((overhead+calldataTokensPerNonZeroByte)*calldataStandardCostPerToken*2),
+		EstimateCalldataCost([]byte{1}, big.NewInt(2)),
+	)
+	assert.Equal(
+		t,
+		new(big.Int).SetUint64((overhead+1)*calldataStandardCostPerToken),
+		EstimateCalldataCost([]byte{0}, big.NewInt(1)),
+	)
+	assert.Equal(
+		t,
+		new(big.Int).SetUint64(32*calldataStandardCostPerToken),
+		EstimateCalldataCost([]byte{}, big.NewInt(1)),
+	)
+
+	// Large txLists are priced at the floor rate, less the execution gas the floor replaces.
+	txList := bytes.Repeat([]byte{1}, 100_000)
+	tokens := uint64(len(txList))*calldataTokensPerNonZeroByte + 32
+	assert.Equal(
+		t,
+		new(big.Int).SetUint64(tokens*calldataFloorCostPerToken-proposeBlockExecutionGas),
+		EstimateCalldataCost(txList, big.NewInt(1)),
+	)
+}
@@ -0,0 +1,161 @@
+package txlistselector
+
+import (
+	"container/heap"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Names of the supported ordering policies.
+const (
+	OrderingPool        = "pool"
+	OrderingLocalFirst  = "localFirst"
+	OrderingPriorityFee = "priorityFee"
+)
+
+// OrderingPolicy orders the transactions of a transactions list before it is proposed. Every policy
+// keeps the transactions of each sender in their nonce order.
+type OrderingPolicy interface {
+	Order(txs types.Transactions, baseFee *big.Int) (types.Transactions, error)
+}
+
+// NewOrderingPolicy creates the ordering policy with the given name, the pool order if empty.
+func NewOrderingPolicy(name string, signer types.Signer, locals []common.Address) (OrderingPolicy, error) {
+	switch name {
+	case OrderingPool, "":
+		return &PoolOrderingPolicy{}, nil
+	case OrderingLocalFirst:
+		return &LocalFirstOrderingPolicy{signer: signer, locals: locals}, nil
+	case OrderingPriorityFee:
+		return &PriorityFeeOrderingPolicy{signer: signer}, nil
+	default:
+		return nil, fmt.Errorf("unknown transactions ordering policy: %s", name)
+	}
+}
+
+// PoolOrderingPolicy keeps the order the L2 execution engine's tx pool returned the transactions in.
+type PoolOrderingPolicy struct{}
+
+// Order implements the OrderingPolicy interface.
+func (o *PoolOrderingPolicy) Order(txs types.Transactions, _ *big.Int) (types.Transactions, error) {
+	return txs, nil
+}
+
+// LocalFirstOrderingPolicy moves the transactions sent by local addresses to the front of the list,
+// otherwise keeping the pool order.
+type LocalFirstOrderingPolicy struct {
+	signer types.Signer
+	locals []common.Address
+}
+
+// Order implements the OrderingPolicy interface.
+func (o *LocalFirstOrderingPolicy) Order(txs types.Transactions, _ *big.Int) (types.Transactions, error) {
+	var (
+		local  = make(types.Transactions, 0, len(txs))
+		remote = make(types.Transactions, 0, len(txs))
+	)
+	for _, tx := range txs {
+		sender, err := types.Sender(o.signer, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		if slices.Contains(o.locals, sender) {
+			local = append(local, tx)
+		} else {
+			remote = append(remote, tx)
+		}
+	}
+
+	return append(local, remote...), nil
+}
+
+// PriorityFeeOrderingPolicy orders the transactions by their effective priority fee, highest first,
+// while each sender's transactions stay in nonce order, like the L1 miner does.
+type PriorityFeeOrderingPolicy struct {
+	signer types.Signer
+}
+
+// Order implements the OrderingPolicy interface.
+func (o *PriorityFeeOrderingPolicy) Order(txs types.Transactions, baseFee *big.Int) (types.Transactions, error) {
+	var (
+		senders []common.Address
+		queues  = make(map[common.Address]types.Transactions)
+	)
+	for _, tx := range txs {
+		sender, err := types.Sender(o.signer, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := queues[sender]; !ok {
+			senders = append(senders, sender)
+		}
+		queues[sender] = append(queues[sender], tx)
+	}
+
+	heads := &txHeads{baseFee: baseFee}
+	for i, sender := range senders {
+		heads.queues = append(heads.queues, &txQueue{txs: queues[sender], index: i})
+	}
+	heap.Init(heads)
+
+	ordered := make(types.Transactions, 0, len(txs))
+	for heads.Len() > 0 {
+		queue := heads.queues[0]
+		ordered = append(ordered, queue.txs[0])
+
+		if len(queue.txs) == 1 {
+			heap.Pop(heads)
+		} else {
+			queue.txs = queue.txs[1:]
+			heap.Fix(heads, 0)
+		}
+	}
+
+	return ordered, nil
+}
+
+// txQueue is a sender's transactions in nonce order, and the position of the sender's first
+// transaction in the pool order.
+type txQueue struct {
+	txs   types.Transactions
+	index int
+}
+
+// txHeads is a max heap of per sender transaction queues, by the effective priority fee of the
+// first transaction of each queue. Equal fees keep their pool order.
+type txHeads struct {
+	queues  []*txQueue
+	baseFee *big.Int
+}
+
+func (h *txHeads) Len() int { return len(h.queues) }
+
+func (h *txHeads) Less(i, j int) bool {
+	tipI, _ := h.queues[i].txs[0].EffectiveGasTip(h.baseFee)
+	tipJ, _ := h.queues[j].txs[0].EffectiveGasTip(h.baseFee)
+
+	if cmp := tipI.Cmp(tipJ); cmp != 0 {
+		return cmp > 0
+	}
+
+	return h.queues[i].index < h.queues[j].index
+}
+
+func (h *txHeads) Swap(i, j int) { h.queues[i], h.queues[j] = h.queues[j], h.queues[i] }
+
+func (h *txHeads) Push(x any) { h.queues = append(h.queues, x.(*txQueue)) }
+
+func (h *txHeads) Pop() any {
+	old := h.queues
+	n := len(old)
+	x := old[n-1]
+	h.queues = old[:n-1]
+
+	return x
+}
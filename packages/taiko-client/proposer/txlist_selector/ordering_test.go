@@ -0,0 +1,87 @@
+package txlistselector
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+var testSigner = types.LatestSignerForChainID(big.NewInt(167001))
+
+func newTestTx(t *testing.T, key *ecdsa.PrivateKey, nonce uint64, gasTipCap int64) *types.Transaction {
+	to := common.HexToAddress("0x1")
+	tx, err := types.SignNewTx(key, testSigner, &types.DynamicFeeTx{
+		ChainID:   big.NewInt(167001),
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(gasTipCap),
+		GasFeeCap: big.NewInt(100 * params.GWei),
+		Gas:       21_000,
+		To:        &to,
+	})
+	require.Nil(t, err)
+
+	return tx
+}
+
+func newTestKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	return key
+}
+
+func TestNewOrderingPolicy(t *testing.T) {
+	for _, name := range []string{"", OrderingPool, OrderingLocalFirst, OrderingPriorityFee} {
+		_, err := NewOrderingPolicy(name, testSigner, nil)
+		require.Nil(t, err)
+	}
+
+	_, err := NewOrderingPolicy("random", testSigner, nil)
+	require.NotNil(t, err)
+}
+
+func TestLocalFirstOrderingPolicy(t *testing.T) {
+	var (
+		local  = newTestKey(t)
+		remote = newTestKey(t)
+		txs    = types.Transactions{
+			newTestTx(t, remote, 0, 1),
+			newTestTx(t, local, 0, 1),
+			newTestTx(t, remote, 1, 1),
+			newTestTx(t, local, 1, 1),
+		}
+	)
+
+	ordered, err := (&LocalFirstOrderingPolicy{
+		signer: testSigner,
+		locals: []common.Address{crypto.PubkeyToAddress(local.PublicKey)},
+	}).Order(txs, common.Big0)
+	require.Nil(t, err)
+	require.Equal(t, types.Transactions{txs[1], txs[3], txs[0], txs[2]}, ordered)
+}
+
+func TestPriorityFeeOrderingPolicy(t *testing.T) {
+	var (
+		a   = newTestKey(t)
+		b   = newTestKey(t)
+		c   = newTestKey(t)
+		txs = types.Transactions{
+			newTestTx(t, a, 0, 1),
+			newTestTx(t, a, 1, 10),
+			newTestTx(t, b, 0, 5),
+			newTestTx(t, c, 0, 5),
+		}
+	)
+
+	ordered, err := (&PriorityFeeOrderingPolicy{signer: testSigner}).Order(txs, common.Big0)
+	require.Nil(t, err)
+	// a's second transaction pays the most, but must come after a's first one, and equal fees keep
+	// the pool order.
+	require.Equal(t, types.Transactions{txs[2], txs[3], txs[0], txs[1]}, ordered)
+}
@@ -0,0 +1,235 @@
+package txlistselector
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	builder "github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/transaction_builder"
+)
+
+// TxListSelector decides which of the transactions lists fetched from the L2 execution engine's
+// tx pool are proposed in an epoch, and how their transactions are ordered.
+type TxListSelector interface {
+	// Select returns the transactions lists to propose. When force is set, the minimum proposing
+	// interval has passed, and all the given transactions lists must be proposed.
+	Select(ctx context.Context, txLists []types.Transactions, force bool) ([]types.Transactions, error)
+}
+
+// DefaultSelector proposes every transactions list, ordered by its ordering policy.
+type DefaultSelector struct {
+	rpc      *rpc.Client
+	ordering OrderingPolicy
+}
+
+// NewDefaultSelector creates a new DefaultSelector instance.
+func NewDefaultSelector(rpc *rpc.Client, ordering OrderingPolicy) *DefaultSelector {
+	return &DefaultSelector{rpc: rpc, ordering: ordering}
+}
+
+// Select implements the TxListSelector interface.
+func (s *DefaultSelector) Select(
+	ctx context.Context,
+	txLists []types.Transactions,
+	_ bool,
+) ([]types.Transactions, error) {
+	baseFee, err := s.rpc.L2NextBaseFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return orderTxLists(s.ordering, txLists, baseFee)
+}
+
+// ProfitAwareSelector only proposes the transactions lists whose L2 fee revenue covers the
+// estimated L1 cost of proposing them, so that unprofitable epochs are delayed until the minimum
+// proposing interval forces a proposal.
+type ProfitAwareSelector struct {
+	rpc      *rpc.Client
+	ordering OrderingPolicy
+	codec    utils.TxListCodec
+	// proposeBlockGas is the estimated L1 gas a TaikoL1.proposeBlock transaction uses, besides the
+	// gas of its txList data.
+	proposeBlockGas    uint64
+	baseFeeSharingPctg uint64
+	// blobAllowed and calldataAllowed are whether the transaction builder proposes with blobs,
+	// calldata or whichever of them is cheaper.
+	blobAllowed     bool
+	calldataAllowed bool
+}
+
+// NewProfitAwareSelector creates a new ProfitAwareSelector instance.
+func NewProfitAwareSelector(
+	rpc *rpc.Client,
+	ordering OrderingPolicy,
+	codec utils.TxListCodec,
+	proposeBlockGas uint64,
+	baseFeeSharingPctg uint64,
+	blobAllowed bool,
+	calldataAllowed bool,
+) *ProfitAwareSelector {
+	return &ProfitAwareSelector{
+		rpc:                rpc,
+		ordering:           ordering,
+		codec:              codec,
+		proposeBlockGas:    proposeBlockGas,
+		baseFeeSharingPctg: baseFeeSharingPctg,
+		blobAllowed:        blobAllowed,
+		calldataAllowed:    calldataAllowed,
+	}
+}
+
+// Select implements the TxListSelector interface.
+func (s *ProfitAwareSelector) Select(
+	ctx context.Context,
+	txLists []types.Transactions,
+	force bool,
+) ([]types.Transactions, error) {
+	l2BaseFee, err := s.rpc.L2NextBaseFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if txLists, err = orderTxLists(s.ordering, txLists, l2BaseFee); err != nil {
+		return nil, err
+	}
+
+	// Propose anyway, once the minimum proposing interval has passed.
+	if force {
+		return txLists, nil
+	}
+
+	l1Head, err := s.rpc.L1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	l1GasTipCap, err := s.rpc.L1.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var blobBaseFee *big.Int
+	if s.blobAllowed && l1Head.ExcessBlobGas != nil {
+		blobBaseFee = eip4844.CalcBlobFee(*l1Head.ExcessBlobGas)
+	}
+	// The builders fall back to calldata before Cancun.
+	calldataAllowed := s.calldataAllowed || blobBaseFee == nil
+
+	l1GasPrice := new(big.Int).Add(l1Head.BaseFee, l1GasTipCap)
+
+	var selected []types.Transactions
+	for i, txs := range txLists {
+		txListBytes, err := rlp.EncodeToBytes(txs)
+		if err != nil {
+			return nil, err
+		}
+
+		compressedTxListBytes, err := s.codec.Compress(txListBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			revenue = estimateRevenue(txs, l2BaseFee, s.baseFeeSharingPctg)
+			cost    = estimateProposingCost(
+				compressedTxListBytes,
+				s.proposeBlockGas,
+				l1GasPrice,
+				blobBaseFee,
+				calldataAllowed,
+			)
+		)
+
+		if revenue.Cmp(cost) < 0 {
+			log.Info(
+				"Unprofitable transactions list skipped",
+				"index", i,
+				"txs", txs.Len(),
+				"revenue", utils.WeiToEther(revenue),
+				"cost", utils.WeiToEther(cost),
+			)
+			metrics.ProposerUnprofitableTxListsCounter.Add(1)
+			continue
+		}
+
+		selected = append(selected, txs)
+	}
+
+	return selected, nil
+}
+
+// orderTxLists orders the transactions of each of the given transactions lists.
+func orderTxLists(
+	ordering OrderingPolicy,
+	txLists []types.Transactions,
+	baseFee *big.Int,
+) ([]types.Transactions, error) {
+	ordered := make([]types.Transactions, 0, len(txLists))
+	for _, txs := range txLists {
+		orderedTxs, err := ordering.Order(txs, baseFee)
+		if err != nil {
+			return nil, err
+		}
+
+		ordered = append(ordered, orderedTxs)
+	}
+
+	return ordered, nil
+}
+
+// estimateRevenue returns the fees the proposer earns for proposing the given transactions: their
+// priority fees, and its share of their base fees. The transactions' gas limits are used as the
+// gas they use, which overestimates the revenue of transactions which do not use all their gas.
+func estimateRevenue(txs types.Transactions, baseFee *big.Int, baseFeeSharingPctg uint64) *big.Int {
+	var (
+		revenue  = new(big.Int)
+		totalGas = new(big.Int)
+	)
+	for _, tx := range txs {
+		// Transactions which can not pay the base fee will not be included.
+		gasTipCap, err := tx.EffectiveGasTip(baseFee)
+		if err != nil {
+			continue
+		}
+
+		gas := new(big.Int).SetUint64(tx.Gas())
+		totalGas.Add(totalGas, gas)
+		revenue.Add(revenue, new(big.Int).Mul(gasTipCap, gas))
+	}
+
+	baseFeeRevenue := new(big.Int).Mul(totalGas, baseFee)
+	baseFeeRevenue.Mul(baseFeeRevenue, new(big.Int).SetUint64(baseFeeSharingPctg))
+	baseFeeRevenue.Div(baseFeeRevenue, big.NewInt(100))
+
+	return revenue.Add(revenue, baseFeeRevenue)
+}
+
+// estimateProposingCost returns the L1 cost of proposing the given compressed txList, in the
+// cheaper of a blob, if blobBaseFee is not nil, and calldata, if calldataAllowed is set.
+func estimateProposingCost(
+	compressedTxListBytes []byte,
+	proposeBlockGas uint64,
+	gasPrice *big.Int,
+	blobBaseFee *big.Int,
+	calldataAllowed bool,
+) *big.Int {
+	var dataCost *big.Int
+	if calldataAllowed {
+		dataCost = builder.EstimateCalldataCost(compressedTxListBytes, gasPrice)
+	}
+	if blobBaseFee != nil {
+		if blobCost := builder.EstimateBlobCost(blobBaseFee); dataCost == nil || blobCost.Cmp(dataCost) < 0 {
+			dataCost = blobCost
+		}
+	}
+
+	return new(big.Int).Add(new(big.Int).Mul(new(big.Int).SetUint64(proposeBlockGas), gasPrice), dataCost)
+}
@@ -0,0 +1,59 @@
+package txlistselector
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	builder "github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/transaction_builder"
+)
+
+func TestEstimateRevenue(t *testing.T) {
+	key := newTestKey(t)
+	txs := types.Transactions{
+		newTestTx(t, key, 0, params.GWei),
+		newTestTx(t, key, 1, 2*params.GWei),
+	}
+
+	baseFee := big.NewInt(10 * params.GWei)
+
+	// Priority fees only.
+	require.Equal(t, big.NewInt(21_000*3*params.GWei), estimateRevenue(txs, baseFee, 0))
+	// Priority fees and half of the base fees.
+	require.Equal(
+		t,
+		big.NewInt(21_000*3*params.GWei+21_000*2*10*params.GWei/2),
+		estimateRevenue(txs, baseFee, 50),
+	)
+	// Transactions which can not pay the base fee earn nothing.
+	require.Equal(t, new(big.Int), estimateRevenue(txs, big.NewInt(1000*params.GWei), 50))
+}
+
+func TestEstimateProposingCost(t *testing.T) {
+	var (
+		txList              = []byte{1, 2, 3}
+		gasPrice            = big.NewInt(params.GWei)
+		calldata            = builder.EstimateCalldataCost(txList, gasPrice)
+		fixed               = new(big.Int).Mul(big.NewInt(100_000), gasPrice)
+		cheapBlob           = big.NewInt(1)
+		expensive           = new(big.Int).Mul(calldata, big.NewInt(100))
+		withProposeBlockGas = func(dataCost *big.Int) *big.Int { return new(big.Int).Add(fixed, dataCost) }
+	)
+
+	require.Equal(t, withProposeBlockGas(calldata), estimateProposingCost(txList, 100_000, gasPrice, nil, true))
+	require.Equal(
+		t,
+		withProposeBlockGas(builder.EstimateBlobCost(cheapBlob)),
+		estimateProposingCost(txList, 100_000, gasPrice, cheapBlob, true),
+	)
+	// An expensive blob is only used when calldata is not allowed.
+	require.Equal(t, withProposeBlockGas(calldata), estimateProposingCost(txList, 100_000, gasPrice, expensive, true))
+	require.Equal(
+		t,
+		withProposeBlockGas(builder.EstimateBlobCost(expensive)),
+		estimateProposingCost(txList, 100_000, gasPrice, expensive, false),
+	)
+}
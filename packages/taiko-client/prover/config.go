@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
@@ -17,6 +18,7 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
 	pkgFlags "github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/flags"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/jwt"
+	proofProducer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
 )
 
 // Config contains the configurations to initialize a Taiko prover.
@@ -55,11 +57,15 @@ type Config struct {
 	MaxBlockSlippage                        uint64
 	Allowance                               *big.Int
 	GuardianProverHealthCheckServerEndpoint *url.URL
-	RaikoHostEndpoint                       string
+	RaikoHostEndpoints                      []string
+	RaikoHedgeDelay                         time.Duration
 	RaikoJWT                                string
+	RaikoZKVMHostEndpoint                   string
+	RaikoZKVMProofType                      string
 	L1NodeVersion                           string
 	L2NodeVersion                           string
 	BlockConfirmations                      uint64
+	JobStorePath                            string
 	TxmgrConfigs                            *txmgr.CLIConfig
 }
 
@@ -144,6 +150,28 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, errors.New("empty raiko host endpoint")
 	}
 
+	var raikoHostEndpoints []string
+	if c.IsSet(flags.RaikoHostEndpoint.Name) {
+		for _, endpoint := range strings.Split(c.String(flags.RaikoHostEndpoint.Name), ",") {
+			raikoHostEndpoints = append(raikoHostEndpoints, strings.TrimSpace(endpoint))
+		}
+	}
+
+	raikoZKVMHostEndpoint := c.String(flags.RaikoZKVMHostEndpoint.Name)
+	if raikoZKVMHostEndpoint == "" && len(raikoHostEndpoints) != 0 {
+		raikoZKVMHostEndpoint = raikoHostEndpoints[0]
+	}
+
+	raikoZKVMProofType := c.String(flags.RaikoZKVMProofType.Name)
+	// RISC0 is the only zkVM the protocol has a verifier for.
+	if raikoZKVMProofType != proofProducer.ProofTypeRISC0 {
+		return nil, fmt.Errorf(
+			"invalid zkVM proof type: %s, only %s is supported",
+			raikoZKVMProofType,
+			proofProducer.ProofTypeRISC0,
+		)
+	}
+
 	if c.IsSet(flags.RaikoJWTPath.Name) {
 		jwtSecret, err = jwt.ParseSecretFromFile(c.String(flags.RaikoJWTPath.Name))
 		if err != nil {
@@ -161,8 +189,11 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		TaikoTokenAddress:                       common.HexToAddress(c.String(flags.TaikoTokenAddress.Name)),
 		ProverSetAddress:                        common.HexToAddress(c.String(flags.ProverSetAddress.Name)),
 		L1ProverPrivKey:                         l1ProverPrivKey,
-		RaikoHostEndpoint:                       c.String(flags.RaikoHostEndpoint.Name),
+		RaikoHostEndpoints:                      raikoHostEndpoints,
+		RaikoHedgeDelay:                         c.Duration(flags.RaikoHedgeDelay.Name),
 		RaikoJWT:                                common.Bytes2Hex(jwtSecret),
+		RaikoZKVMHostEndpoint:                   raikoZKVMHostEndpoint,
+		RaikoZKVMProofType:                      raikoZKVMProofType,
 		StartingBlockID:                         startingBlockID,
 		Dummy:                                   c.Bool(flags.Dummy.Name),
 		GuardianProverMinorityAddress:           common.HexToAddress(c.String(flags.GuardianProverMinority.Name)),
@@ -191,6 +222,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		L1NodeVersion:                           c.String(flags.L1NodeVersion.Name),
 		L2NodeVersion:                           c.String(flags.L2NodeVersion.Name),
 		BlockConfirmations:                      c.Uint64(flags.BlockConfirmations.Name),
+		JobStorePath:                            c.String(flags.JobStorePath.Name),
 		TxmgrConfigs: pkgFlags.InitTxmgrConfigsFromCli(
 			c.String(flags.L1HTTPEndpoint.Name),
 			l1ProverPrivKey,
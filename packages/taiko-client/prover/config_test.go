@@ -11,6 +11,7 @@ import (
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
+	proofProducer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
 )
 
 var (
@@ -54,6 +55,8 @@ func (s *ProverTestSuite) TestNewConfigFromCliContextGuardianProver() {
 		s.Equal(tierFeeGWei.Uint64(), c.MinSgxTierFee.Uint64())
 		s.Equal(c.L1NodeVersion, l1NodeVersion)
 		s.Equal(c.L2NodeVersion, l2NodeVersion)
+		s.Equal("https://dummy.raiko.xyz", c.RaikoZKVMHostEndpoint)
+		s.Equal(proofProducer.ProofTypeRISC0, c.RaikoZKVMProofType)
 		s.Nil(new(Prover).InitFromCli(context.Background(), ctx))
 		s.True(c.ProveUnassignedBlocks)
 		s.Equal(uint64(100), c.MaxProposedIn)
@@ -101,6 +104,17 @@ func (s *ProverTestSuite) TestNewConfigFromCliContextProverKeyError() {
 	}), "invalid L1 prover private key")
 }
 
+func (s *ProverTestSuite) TestNewConfigFromCliContextZKVMProofTypeError() {
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContext",
+		"--" + flags.L1ProverPrivKey.Name, os.Getenv("L1_PROVER_PRIVATE_KEY"),
+		"--" + flags.RaikoHostEndpoint.Name, "https://dummy.raiko.xyz",
+		"--" + flags.RaikoZKVMProofType.Name, "sp1",
+	}), "invalid zkVM proof type")
+}
+
 func (s *ProverTestSuite) SetupApp() *cli.App {
 	app := cli.NewApp()
 	app.Flags = []cli.Flag{
@@ -127,6 +141,8 @@ func (s *ProverTestSuite) SetupApp() *cli.App {
 		&cli.StringFlag{Name: flags.L1NodeVersion.Name},
 		&cli.StringFlag{Name: flags.L2NodeVersion.Name},
 		&cli.StringFlag{Name: flags.RaikoHostEndpoint.Name},
+		&cli.StringFlag{Name: flags.RaikoZKVMHostEndpoint.Name},
+		flags.RaikoZKVMProofType,
 	}
 	app.Flags = append(app.Flags, flags.TxmgrFlags...)
 	app.Action = func(ctx *cli.Context) error {
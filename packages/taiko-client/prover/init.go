@@ -21,6 +21,10 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_submitter/transaction"
 )
 
+// raikoMaxConsecutiveErrors is the number of consecutive failed requests to a Raiko host after which
+// a proof request fails over to another host.
+const raikoMaxConsecutiveErrors = 3
+
 // setApprovalAmount will set the allowance on the TaikoToken contract for the
 // configured proverAddress as owner and the contract as spender,
 // if `--prover.allowance` flag is provided for allowance.
@@ -107,10 +111,14 @@ func (p *Prover) initProofSubmitters(
 		case encoding.TierOptimisticID:
 			producer = &proofProducer.OptimisticProofProducer{}
 		case encoding.TierSgxID:
-			producer = &proofProducer.SGXProofProducer{
-				RaikoHostEndpoint: p.cfg.RaikoHostEndpoint,
+			if producer, err = p.initSgxProofProducer(); err != nil {
+				return err
+			}
+		case encoding.TierSgxAndZkVMID:
+			producer = &proofProducer.ZKvmProofProducer{
+				RaikoHostEndpoint: p.cfg.RaikoZKVMHostEndpoint,
+				ZKProofType:       p.cfg.RaikoZKVMProofType,
 				JWT:               p.cfg.RaikoJWT,
-				ProofType:         proofProducer.ProofTypeSgx,
 				Dummy:             p.cfg.Dummy,
 			}
 		case encoding.TierGuardianMinorityID:
@@ -144,6 +152,41 @@ func (p *Prover) initProofSubmitters(
 	return nil
 }
 
+// initSgxProofProducer initializes the SGX proof producer, spreading the proof requests across all
+// the configured Raiko hosts if there are several of them.
+func (p *Prover) initSgxProofProducer() (proofProducer.ProofProducer, error) {
+	if len(p.cfg.RaikoHostEndpoints) <= 1 {
+		var endpoint string
+		if len(p.cfg.RaikoHostEndpoints) == 1 {
+			endpoint = p.cfg.RaikoHostEndpoints[0]
+		}
+
+		return &proofProducer.SGXProofProducer{
+			RaikoHostEndpoint: endpoint,
+			JWT:               p.cfg.RaikoJWT,
+			ProofType:         proofProducer.ProofTypeSgx,
+			Dummy:             p.cfg.Dummy,
+		}, nil
+	}
+
+	var backends []*proofProducer.ProducerBackend
+	for _, endpoint := range p.cfg.RaikoHostEndpoints {
+		backends = append(backends, &proofProducer.ProducerBackend{
+			Name: endpoint,
+			Producer: &proofProducer.SGXProofProducer{
+				RaikoHostEndpoint: endpoint,
+				JWT:               p.cfg.RaikoJWT,
+				ProofType:         proofProducer.ProofTypeSgx,
+				Dummy:             p.cfg.Dummy,
+				// Fail over to another Raiko host, instead of retrying a failing one forever.
+				MaxConsecutiveErrors: raikoMaxConsecutiveErrors,
+			},
+		})
+	}
+
+	return proofProducer.NewCompositeProofProducer(backends, p.cfg.RaikoHedgeDelay)
+}
+
 // initL1Current initializes prover's L1Current cursor.
 func (p *Prover) initL1Current(startingBlockID *big.Int) error {
 	if err := p.rpc.WaitTillL2ExecutionEngineSynced(p.ctx); err != nil {
@@ -0,0 +1,269 @@
+package store
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/leveldb"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	proofProducer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
+)
+
+var (
+	// ErrJobNotFound is returned when there is no proof job stored for the given block.
+	ErrJobNotFound = errors.New("proof job not found")
+
+	jobKeyPrefix = []byte("job-")
+)
+
+const (
+	leveldbCache   = 16
+	leveldbHandles = 16
+)
+
+// Status is the state of a block's proof lifecycle.
+type Status string
+
+// All proof job statuses, in lifecycle order.
+const (
+	StatusRequested  Status = "requested"
+	StatusGenerating Status = "generating"
+	StatusGenerated  Status = "generated"
+	StatusSubmitted  Status = "submitted"
+	StatusConfirmed  Status = "confirmed"
+)
+
+// Job is the proof job of a single L2 block.
+type Job struct {
+	BlockID *big.Int                             `json:"blockID"`
+	Tier    uint16                               `json:"tier"`
+	Status  Status                               `json:"status"`
+	Event   *bindings.TaikoL1ClientBlockProposed `json:"event"`
+	// The proof producer response, set once the proof is generated.
+	Proof  []byte                             `json:"proof,omitempty"`
+	Opts   *proofProducer.ProofRequestOptions `json:"opts,omitempty"`
+	Header *types.Header                      `json:"header,omitempty"`
+	// The TaikoL1.proveBlock transaction, set once the proof is confirmed by this prover.
+	TxHash    common.Hash `json:"txHash"`
+	UpdatedAt time.Time   `json:"updatedAt"`
+}
+
+// ProofWithHeader returns the generated proof of the job, or nil if the proof is not generated yet.
+func (j *Job) ProofWithHeader() *proofProducer.ProofWithHeader {
+	if j.Proof == nil || j.Header == nil || j.Opts == nil || j.Event == nil {
+		return nil
+	}
+
+	return &proofProducer.ProofWithHeader{
+		BlockID: j.BlockID,
+		Meta:    &j.Event.Meta,
+		Header:  j.Header,
+		Proof:   j.Proof,
+		Opts:    j.Opts,
+		Tier:    j.Tier,
+	}
+}
+
+// JobStore is an on-disk store of the prover's proof jobs, so that the proofs in progress survive
+// restarts.
+type JobStore struct {
+	db    ethdb.KeyValueStore
+	mutex sync.Mutex
+}
+
+// New creates a new JobStore instance, backed by a LevelDB database in the given directory, or by
+// an in-memory database if the directory is empty.
+func New(path string) (*JobStore, error) {
+	if path == "" {
+		return &JobStore{db: memorydb.New()}, nil
+	}
+
+	db, err := leveldb.New(path, leveldbCache, leveldbHandles, "prover/jobstore/", false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JobStore{db: db}, nil
+}
+
+// Close closes the underlying database.
+func (s *JobStore) Close() error {
+	return s.db.Close()
+}
+
+// Get returns the proof job of the given block.
+func (s *JobStore) Get(blockID *big.Int) (*Job, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.get(blockID)
+}
+
+// Pending returns all proof jobs which are not confirmed yet, in block ID order.
+func (s *JobStore) Pending() ([]*Job, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	it := s.db.NewIterator(jobKeyPrefix, nil)
+	defer it.Release()
+
+	var jobs []*Job
+	for it.Next() {
+		job := new(Job)
+		if err := json.Unmarshal(it.Value(), job); err != nil {
+			return nil, err
+		}
+		if job.Status != StatusConfirmed {
+			jobs = append(jobs, job)
+		}
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].BlockID.Cmp(jobs[j].BlockID) < 0 })
+
+	return jobs, nil
+}
+
+// MarkRequested records a new proof request. A request for a different tier, or for a block
+// proposed again after an L1 reorg, restarts the stored job's lifecycle, otherwise the stored job
+// is kept, so that a block is never proven twice.
+func (s *JobStore) MarkRequested(req *proofProducer.ProofRequestBody) (*Job, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	job, err := s.get(req.Event.BlockId)
+	if err != nil && !errors.Is(err, ErrJobNotFound) {
+		return nil, err
+	}
+	if job != nil && job.Tier == req.Tier && job.Event.Raw.BlockHash == req.Event.Raw.BlockHash {
+		return job, nil
+	}
+
+	job = &Job{BlockID: req.Event.BlockId, Tier: req.Tier, Status: StatusRequested, Event: req.Event}
+	return job, s.put(job)
+}
+
+// MarkGenerating records that the proof producer started generating the proof of the given block.
+func (s *JobStore) MarkGenerating(blockID *big.Int) error {
+	return s.update(blockID, func(job *Job) { job.Status = StatusGenerating })
+}
+
+// MarkGenerationFailed records that the proof producer failed to generate the proof of the given
+// block, so it should be requested again.
+func (s *JobStore) MarkGenerationFailed(blockID *big.Int) error {
+	return s.update(blockID, func(job *Job) { job.Status = StatusRequested })
+}
+
+// MarkGenerated records the proof producer response of the given proof.
+func (s *JobStore) MarkGenerated(proofWithHeader *proofProducer.ProofWithHeader) error {
+	return s.update(proofWithHeader.BlockID, func(job *Job) {
+		job.Status = StatusGenerated
+		job.Tier = proofWithHeader.Tier
+		job.Proof = proofWithHeader.Proof
+		job.Opts = proofWithHeader.Opts
+		job.Header = proofWithHeader.Header
+	})
+}
+
+// MarkSubmitted records that the proof of the given block is being submitted to TaikoL1.
+func (s *JobStore) MarkSubmitted(blockID *big.Int) error {
+	return s.update(blockID, func(job *Job) { job.Status = StatusSubmitted })
+}
+
+// MarkConfirmed records that the proof of the given block has landed on chain.
+func (s *JobStore) MarkConfirmed(blockID *big.Int, txHash common.Hash) error {
+	return s.update(blockID, func(job *Job) {
+		job.Status = StatusConfirmed
+		job.TxHash = txHash
+	})
+}
+
+// Prune deletes the proof jobs of all blocks up to the given verified block.
+func (s *JobStore) Prune(lastVerifiedBlockID *big.Int) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	it := s.db.NewIterator(jobKeyPrefix, nil)
+	defer it.Release()
+
+	batch := s.db.NewBatch()
+	for it.Next() {
+		if new(big.Int).SetBytes(it.Key()[len(jobKeyPrefix):]).Cmp(lastVerifiedBlockID) > 0 {
+			break
+		}
+		if err := batch.Delete(common.CopyBytes(it.Key())); err != nil {
+			return err
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	return batch.Write()
+}
+
+// update applies the given change to the stored proof job of the given block, if there is one.
+func (s *JobStore) update(blockID *big.Int, change func(job *Job)) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	job, err := s.get(blockID)
+	if err != nil {
+		if errors.Is(err, ErrJobNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	change(job)
+
+	return s.put(job)
+}
+
+// get returns the stored proof job of the given block.
+func (s *JobStore) get(blockID *big.Int) (*Job, error) {
+	data, err := s.db.Get(jobKey(blockID))
+	if err != nil {
+		if ok, _ := s.db.Has(jobKey(blockID)); !ok {
+			return nil, ErrJobNotFound
+		}
+		return nil, err
+	}
+
+	job := new(Job)
+	if err := json.Unmarshal(data, job); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// put stores the given proof job.
+func (s *JobStore) put(job *Job) error {
+	job.UpdatedAt = time.Now()
+
+	data, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Put(jobKey(job.BlockID), data)
+}
+
+// jobKey returns the database key of the given block's proof job, big endian encoded so that the
+// jobs are iterated in block ID order.
+func jobKey(blockID *big.Int) []byte {
+	return binary.BigEndian.AppendUint64(common.CopyBytes(jobKeyPrefix), blockID.Uint64())
+}
@@ -0,0 +1,153 @@
+package store
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	proofProducer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
+)
+
+func newTestRequest(blockID uint64, tier uint16) *proofProducer.ProofRequestBody {
+	return &proofProducer.ProofRequestBody{
+		Tier: tier,
+		Event: &bindings.TaikoL1ClientBlockProposed{
+			BlockId:        new(big.Int).SetUint64(blockID),
+			AssignedProver: common.HexToAddress("0x1"),
+			LivenessBond:   common.Big1,
+			Meta:           bindings.TaikoDataBlockMetadata{Id: blockID, MinTier: tier},
+			Raw: types.Log{
+				Topics:      []common.Hash{common.HexToHash("0x4")},
+				BlockNumber: 100,
+				TxHash:      common.HexToHash("0x2"),
+			},
+		},
+	}
+}
+
+func newTestProof(req *proofProducer.ProofRequestBody) *proofProducer.ProofWithHeader {
+	return &proofProducer.ProofWithHeader{
+		BlockID: req.Event.BlockId,
+		Meta:    &req.Event.Meta,
+		Header:  &types.Header{Number: req.Event.BlockId, Difficulty: common.Big0},
+		Proof:   []byte{1, 2, 3},
+		Opts:    &proofProducer.ProofRequestOptions{BlockID: req.Event.BlockId},
+		Tier:    req.Tier,
+	}
+}
+
+func TestJobLifecycle(t *testing.T) {
+	s, err := New(t.TempDir())
+	require.Nil(t, err)
+	defer s.Close()
+
+	req := newTestRequest(1, 200)
+
+	job, err := s.MarkRequested(req)
+	require.Nil(t, err)
+	require.Equal(t, StatusRequested, job.Status)
+	require.Nil(t, job.ProofWithHeader())
+
+	require.Nil(t, s.MarkGenerating(req.Event.BlockId))
+	job, err = s.Get(req.Event.BlockId)
+	require.Nil(t, err)
+	require.Equal(t, StatusGenerating, job.Status)
+	require.Equal(t, req.Event.Raw.TxHash, job.Event.Raw.TxHash)
+
+	require.Nil(t, s.MarkGenerationFailed(req.Event.BlockId))
+	job, err = s.Get(req.Event.BlockId)
+	require.Nil(t, err)
+	require.Equal(t, StatusRequested, job.Status)
+
+	proof := newTestProof(req)
+	require.Nil(t, s.MarkGenerated(proof))
+	job, err = s.Get(req.Event.BlockId)
+	require.Nil(t, err)
+	require.Equal(t, StatusGenerated, job.Status)
+	require.Equal(t, proof.Proof, job.ProofWithHeader().Proof)
+	require.Equal(t, proof.Header.Hash(), job.ProofWithHeader().Header.Hash())
+
+	// Requesting the same tier again keeps the generated proof.
+	job, err = s.MarkRequested(req)
+	require.Nil(t, err)
+	require.Equal(t, StatusGenerated, job.Status)
+
+	require.Nil(t, s.MarkSubmitted(req.Event.BlockId))
+	require.Nil(t, s.MarkConfirmed(req.Event.BlockId, common.HexToHash("0x3")))
+	job, err = s.Get(req.Event.BlockId)
+	require.Nil(t, err)
+	require.Equal(t, StatusConfirmed, job.Status)
+	require.Equal(t, common.HexToHash("0x3"), job.TxHash)
+
+	// Requesting a higher tier restarts the lifecycle.
+	job, err = s.MarkRequested(newTestRequest(1, 300))
+	require.Nil(t, err)
+	require.Equal(t, StatusRequested, job.Status)
+	require.Nil(t, job.Proof)
+
+	// So does a block proposed again after an L1 reorg.
+	require.Nil(t, s.MarkGenerated(newTestProof(newTestRequest(1, 300))))
+	reorged := newTestRequest(1, 300)
+	reorged.Event.Raw.BlockHash = common.HexToHash("0x5")
+	job, err = s.MarkRequested(reorged)
+	require.Nil(t, err)
+	require.Equal(t, StatusRequested, job.Status)
+	require.Equal(t, reorged.Event.Raw.BlockHash, job.Event.Raw.BlockHash)
+}
+
+func TestJobStorePersistence(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := New(dir)
+	require.Nil(t, err)
+	_, err = s.MarkRequested(newTestRequest(1, 200))
+	require.Nil(t, err)
+	require.Nil(t, s.Close())
+
+	s, err = New(dir)
+	require.Nil(t, err)
+	defer s.Close()
+
+	job, err := s.Get(common.Big1)
+	require.Nil(t, err)
+	require.Equal(t, StatusRequested, job.Status)
+	require.Equal(t, uint16(200), job.Tier)
+}
+
+func TestPendingAndPrune(t *testing.T) {
+	s, err := New("")
+	require.Nil(t, err)
+	defer s.Close()
+
+	for _, blockID := range []uint64{3, 1, 256, 2} {
+		_, err := s.MarkRequested(newTestRequest(blockID, 200))
+		require.Nil(t, err)
+	}
+	require.Nil(t, s.MarkConfirmed(big.NewInt(2), common.Hash{}))
+
+	jobs, err := s.Pending()
+	require.Nil(t, err)
+	require.Len(t, jobs, 3)
+	require.Equal(t, []uint64{1, 3, 256}, []uint64{
+		jobs[0].BlockID.Uint64(),
+		jobs[1].BlockID.Uint64(),
+		jobs[2].BlockID.Uint64(),
+	})
+
+	require.Nil(t, s.Prune(big.NewInt(3)))
+	_, err = s.Get(big.NewInt(3))
+	require.ErrorIs(t, err, ErrJobNotFound)
+	jobs, err = s.Pending()
+	require.Nil(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, uint64(256), jobs[0].BlockID.Uint64())
+
+	// Updating an unknown job is a no-op.
+	require.Nil(t, s.MarkSubmitted(big.NewInt(1)))
+	_, err = s.Get(big.NewInt(1))
+	require.ErrorIs(t, err, ErrJobNotFound)
+}
@@ -0,0 +1,240 @@
+package producer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+)
+
+var (
+	errNoProducerBackends   = errors.New("no proof producer backends")
+	errProofRequestCanceled = errors.New("proof request canceled")
+	// latencyEMAWeight is the weight of the latest proof generation in a backend's latency average.
+	latencyEMAWeight = 0.3
+)
+
+// ProducerBackend is one of the proof producers a CompositeProofProducer spreads requests across,
+// such as an SGXProofProducer talking to a single Raiko host.
+type ProducerBackend struct {
+	Name     string
+	Producer ProofProducer
+
+	// Statistics, guarded by the CompositeProofProducer's mutex.
+	inFlight  int
+	successes uint64
+	failures  uint64
+	latency   time.Duration
+}
+
+// successRate returns the ratio of the backend's successful proof requests, backends without any
+// finished request are optimistically considered reliable.
+func (b *ProducerBackend) successRate() float64 {
+	if b.successes+b.failures == 0 {
+		return 1
+	}
+
+	return float64(b.successes) / float64(b.successes+b.failures)
+}
+
+// CompositeProofProducer is a proof producer spreading proof requests across several backends of
+// the same tier. Each request is sent to the least loaded reliable backend, hedged by sending it to
+// another backend too when no proof is generated before the hedging delay, and failed over to the
+// next backend when a backend fails.
+type CompositeProofProducer struct {
+	backends   []*ProducerBackend
+	hedgeDelay time.Duration
+	tier       uint16
+	// next is the index of the backend preferred by the next request, when the backends are even.
+	next  int
+	mutex sync.Mutex
+}
+
+// NewCompositeProofProducer creates a new CompositeProofProducer instance, a hedgeDelay of 0 disables
+// hedging.
+func NewCompositeProofProducer(backends []*ProducerBackend, hedgeDelay time.Duration) (*CompositeProofProducer, error) {
+	if len(backends) == 0 {
+		return nil, errNoProducerBackends
+	}
+
+	tier := backends[0].Producer.Tier()
+	for _, backend := range backends {
+		if backend.Producer.Tier() != tier {
+			return nil, fmt.Errorf(
+				"proof producer backend %s has tier %d, expected %d",
+				backend.Name,
+				backend.Producer.Tier(),
+				tier,
+			)
+		}
+	}
+
+	return &CompositeProofProducer{backends: backends, hedgeDelay: hedgeDelay, tier: tier}, nil
+}
+
+// attemptResult is the result of a proof request sent to a single backend.
+type attemptResult struct {
+	backend *ProducerBackend
+	proof   *ProofWithHeader
+	err     error
+}
+
+// RequestProof implements the ProofProducer interface.
+func (c *CompositeProofProducer) RequestProof(
+	ctx context.Context,
+	opts *ProofRequestOptions,
+	blockID *big.Int,
+	meta *bindings.TaikoDataBlockMetadata,
+	header *types.Header,
+) (*ProofWithHeader, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	// Cancel the requests which are still in progress once a proof is generated.
+	defer cancel()
+
+	var (
+		candidates = c.rankedBackends()
+		resultCh   = make(chan *attemptResult, len(candidates))
+		pending    int
+		errs       []error
+	)
+
+	// attempt sends the proof request to the next candidate backend, it returns false if all
+	// backends have been tried.
+	attempt := func() bool {
+		if len(candidates) == 0 {
+			return false
+		}
+
+		backend := candidates[0]
+		candidates = candidates[1:]
+		pending++
+
+		c.startRequest(backend)
+		go func() {
+			start := time.Now()
+			proof, err := backend.Producer.RequestProof(ctx, opts, blockID, meta, header)
+			// Some producers give up silently once the context is done.
+			if err == nil && ctx.Err() != nil {
+				err = errProofRequestCanceled
+			}
+			c.finishRequest(backend, time.Since(start), err)
+
+			resultCh <- &attemptResult{backend: backend, proof: proof, err: err}
+		}()
+
+		return true
+	}
+
+	attempt()
+
+	var hedgeCh <-chan time.Time
+	if c.hedgeDelay != 0 {
+		hedgeTimer := time.NewTimer(c.hedgeDelay)
+		defer hedgeTimer.Stop()
+		hedgeCh = hedgeTimer.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-hedgeCh:
+			hedgeCh = nil
+			if len(candidates) != 0 {
+				log.Info("Hedge slow proof request", "blockID", blockID, "backend", candidates[0].Name)
+				attempt()
+			}
+		case res := <-resultCh:
+			pending--
+			if res.err == nil {
+				return res.proof, nil
+			}
+
+			log.Warn("Proof producer backend failed", "blockID", blockID, "backend", res.backend.Name, "error", res.err)
+			errs = append(errs, fmt.Errorf("%s: %w", res.backend.Name, res.err))
+
+			// Fail over to the next backend, if no other request is still in progress.
+			if pending == 0 && !attempt() {
+				return nil, fmt.Errorf("all proof producer backends failed: %w", errors.Join(errs...))
+			}
+		}
+	}
+}
+
+// rankedBackends returns the backends in the order a new request should try them: the least loaded
+// first, then the most reliable, then the fastest, spreading even backends round-robin.
+func (c *CompositeProofProducer) rankedBackends() []*ProducerBackend {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	ranked := make([]*ProducerBackend, 0, len(c.backends))
+	for i := range c.backends {
+		ranked = append(ranked, c.backends[(c.next+i)%len(c.backends)])
+	}
+	c.next = (c.next + 1) % len(c.backends)
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].inFlight != ranked[j].inFlight {
+			return ranked[i].inFlight < ranked[j].inFlight
+		}
+		if ranked[i].successRate() != ranked[j].successRate() {
+			return ranked[i].successRate() > ranked[j].successRate()
+		}
+		return ranked[i].latency < ranked[j].latency
+	})
+
+	return ranked
+}
+
+// startRequest records a new proof request sent to the given backend.
+func (c *CompositeProofProducer) startRequest(backend *ProducerBackend) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	backend.inFlight++
+}
+
+// finishRequest records the result of a proof request sent to the given backend, the requests
+// canceled because another backend was faster are neither a success nor a failure.
+func (c *CompositeProofProducer) finishRequest(backend *ProducerBackend, latency time.Duration, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	backend.inFlight--
+
+	switch {
+	case errors.Is(err, errProofRequestCanceled) || errors.Is(err, context.Canceled):
+		metrics.ProverProofProducerRequestsCounter.WithLabelValues(backend.Name, "canceled").Inc()
+		return
+	case err != nil:
+		backend.failures++
+		metrics.ProverProofProducerRequestsCounter.WithLabelValues(backend.Name, "failure").Inc()
+	default:
+		backend.successes++
+		if backend.latency == 0 {
+			backend.latency = latency
+		} else {
+			backend.latency = time.Duration(
+				latencyEMAWeight*float64(latency) + (1-latencyEMAWeight)*float64(backend.latency),
+			)
+		}
+		metrics.ProverProofProducerRequestsCounter.WithLabelValues(backend.Name, "success").Inc()
+		metrics.ProverProofProducerLatencyGauge.WithLabelValues(backend.Name).Set(backend.latency.Seconds())
+	}
+
+	metrics.ProverProofProducerSuccessRateGauge.WithLabelValues(backend.Name).Set(backend.successRate())
+}
+
+// Tier implements the ProofProducer interface.
+func (c *CompositeProofProducer) Tier() uint16 {
+	return c.tier
+}
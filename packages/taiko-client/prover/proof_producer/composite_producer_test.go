@@ -0,0 +1,191 @@
+package producer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+)
+
+// stubProducer is a proof producer which fails, or generates a proof after a delay.
+type stubProducer struct {
+	delay    time.Duration
+	err      error
+	tier     uint16
+	requests atomic.Int32
+	canceled atomic.Int32
+}
+
+func (p *stubProducer) RequestProof(
+	ctx context.Context,
+	opts *ProofRequestOptions,
+	blockID *big.Int,
+	meta *bindings.TaikoDataBlockMetadata,
+	header *types.Header,
+) (*ProofWithHeader, error) {
+	p.requests.Add(1)
+
+	select {
+	case <-ctx.Done():
+		p.canceled.Add(1)
+		return nil, ctx.Err()
+	case <-time.After(p.delay):
+	}
+
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return &ProofWithHeader{BlockID: blockID, Header: header, Meta: meta, Opts: opts, Tier: p.tier}, nil
+}
+
+func (p *stubProducer) Tier() uint16 { return p.tier }
+
+func newTestCompositeProducer(
+	t *testing.T,
+	hedgeDelay time.Duration,
+	producers ...*stubProducer,
+) *CompositeProofProducer {
+	var backends []*ProducerBackend
+	for i, producer := range producers {
+		backends = append(backends, &ProducerBackend{Name: string(rune('a' + i)), Producer: producer})
+	}
+
+	c, err := NewCompositeProofProducer(backends, hedgeDelay)
+	require.Nil(t, err)
+
+	return c
+}
+
+func requestTestProof(c *CompositeProofProducer) (*ProofWithHeader, error) {
+	return c.RequestProof(
+		context.Background(),
+		&ProofRequestOptions{},
+		common.Big1,
+		&bindings.TaikoDataBlockMetadata{},
+		&types.Header{Number: common.Big1},
+	)
+}
+
+func TestNewCompositeProofProducer(t *testing.T) {
+	_, err := NewCompositeProofProducer(nil, time.Second)
+	require.ErrorIs(t, err, errNoProducerBackends)
+
+	_, err = NewCompositeProofProducer([]*ProducerBackend{
+		{Name: "a", Producer: &stubProducer{tier: encoding.TierSgxID}},
+		{Name: "b", Producer: &stubProducer{tier: encoding.TierOptimisticID}},
+	}, time.Second)
+	require.NotNil(t, err)
+}
+
+func TestCompositeProducerFailover(t *testing.T) {
+	var (
+		failing = &stubProducer{tier: encoding.TierSgxID, err: errors.New("raiko down")}
+		healthy = &stubProducer{tier: encoding.TierSgxID}
+		c       = newTestCompositeProducer(t, 0, failing, healthy)
+	)
+
+	proof, err := requestTestProof(c)
+	require.Nil(t, err)
+	require.Equal(t, encoding.TierSgxID, proof.Tier)
+	require.Equal(t, int32(1), failing.requests.Load())
+	require.Equal(t, int32(1), healthy.requests.Load())
+
+	// The failing backend is not preferred anymore.
+	require.Equal(t, c.backends[1], c.rankedBackends()[0])
+	require.Equal(t, c.backends[1], c.rankedBackends()[0])
+}
+
+func TestCompositeProducerAllFailed(t *testing.T) {
+	c := newTestCompositeProducer(
+		t,
+		0,
+		&stubProducer{tier: encoding.TierSgxID, err: errors.New("raiko a down")},
+		&stubProducer{tier: encoding.TierSgxID, err: errors.New("raiko b down")},
+	)
+
+	_, err := requestTestProof(c)
+	require.ErrorContains(t, err, "raiko a down")
+	require.ErrorContains(t, err, "raiko b down")
+}
+
+func TestCompositeProducerHedging(t *testing.T) {
+	var (
+		wedged = &stubProducer{tier: encoding.TierSgxID, delay: time.Hour}
+		fast   = &stubProducer{tier: encoding.TierSgxID}
+		c      = newTestCompositeProducer(t, 10*time.Millisecond, wedged, fast)
+	)
+
+	_, err := requestTestProof(c)
+	require.Nil(t, err)
+	require.Equal(t, int32(1), fast.requests.Load())
+
+	// The wedged backend's request is canceled, and counted neither as a success nor as a failure.
+	require.Eventually(t, func() bool { return wedged.canceled.Load() == 1 }, time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+		return c.backends[0].inFlight == 0
+	}, time.Second, 10*time.Millisecond)
+	require.Zero(t, c.backends[0].failures)
+	require.Equal(t, uint64(1), c.backends[1].successes)
+}
+
+func TestCompositeProducerLoadBalancing(t *testing.T) {
+	c := newTestCompositeProducer(
+		t,
+		0,
+		&stubProducer{tier: encoding.TierSgxID},
+		&stubProducer{tier: encoding.TierSgxID},
+	)
+
+	// Even backends are used round-robin.
+	require.Equal(t, c.backends[0], c.rankedBackends()[0])
+	require.Equal(t, c.backends[1], c.rankedBackends()[0])
+
+	// The least loaded backend is preferred.
+	c.startRequest(c.backends[0])
+	require.Equal(t, c.backends[1], c.rankedBackends()[0])
+	require.Equal(t, c.backends[1], c.rankedBackends()[0])
+	c.finishRequest(c.backends[0], time.Second, nil)
+
+	// Then the fastest one.
+	c.startRequest(c.backends[1])
+	c.finishRequest(c.backends[1], time.Minute, nil)
+	require.Equal(t, c.backends[0], c.rankedBackends()[0])
+	require.Equal(t, c.backends[0], c.rankedBackends()[0])
+}
+
+func TestSGXProducerMaxConsecutiveErrors(t *testing.T) {
+	defer func(interval time.Duration) { proofPollingInterval = interval }(proofPollingInterval)
+	proofPollingInterval = time.Millisecond
+
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	producer := &SGXProofProducer{RaikoHostEndpoint: srv.URL, ProofType: ProofTypeSgx, MaxConsecutiveErrors: 3}
+	_, err := producer.RequestProof(
+		context.Background(),
+		&ProofRequestOptions{BlockID: common.Big1},
+		common.Big1,
+		&bindings.TaikoDataBlockMetadata{},
+		&types.Header{Number: common.Big1},
+	)
+	require.NotNil(t, err)
+	require.Equal(t, int32(3), requests.Load())
+}
@@ -32,6 +32,9 @@ type SGXProofProducer struct {
 	ProofType         string // Proof type
 	JWT               string // JWT provided by Raiko
 	Dummy             bool
+	// MaxConsecutiveErrors is the number of consecutive failed requests to the Raiko host after which
+	// the proof request fails, 0 to keep retrying until the context is done.
+	MaxConsecutiveErrors uint64
 	DummyProofProducer
 }
 
@@ -110,18 +113,23 @@ func (s *SGXProofProducer) RequestProof(
 // callProverDaemon keeps polling the proverd service to get the requested proof.
 func (s *SGXProofProducer) callProverDaemon(ctx context.Context, opts *ProofRequestOptions) ([]byte, error) {
 	var (
-		proof []byte
-		start = time.Now()
+		proof             []byte
+		start             = time.Now()
+		consecutiveErrors uint64
 	)
 	if err := backoff.Retry(func() error {
 		if ctx.Err() != nil {
 			return nil
 		}
-		output, err := s.requestProof(opts)
+		output, err := s.requestProof(ctx, opts)
 		if err != nil {
 			log.Error("Failed to request proof", "height", opts.BlockID, "error", err, "endpoint", s.RaikoHostEndpoint)
+			if consecutiveErrors++; s.MaxConsecutiveErrors != 0 && consecutiveErrors >= s.MaxConsecutiveErrors {
+				return backoff.Permanent(err)
+			}
 			return err
 		}
+		consecutiveErrors = 0
 
 		if output == nil {
 			log.Info(
@@ -158,7 +166,10 @@ func (s *SGXProofProducer) callProverDaemon(ctx context.Context, opts *ProofRequ
 }
 
 // requestProof sends a RPC request to proverd to try to get the requested proof.
-func (s *SGXProofProducer) requestProof(opts *ProofRequestOptions) (*RaikoRequestProofBodyResponse, error) {
+func (s *SGXProofProducer) requestProof(
+	ctx context.Context,
+	opts *ProofRequestOptions,
+) (*RaikoRequestProofBodyResponse, error) {
 	reqBody := RaikoRequestProofBody{
 		Type:     s.ProofType,
 		Block:    opts.BlockID,
@@ -178,7 +189,7 @@ func (s *SGXProofProducer) requestProof(opts *ProofRequestOptions) (*RaikoReques
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", s.RaikoHostEndpoint+"/v1/proof", bytes.NewBuffer(jsonValue))
+	req, err := http.NewRequestWithContext(ctx, "POST", s.RaikoHostEndpoint+"/v1/proof", bytes.NewBuffer(jsonValue))
 	if err != nil {
 		return nil, err
 	}
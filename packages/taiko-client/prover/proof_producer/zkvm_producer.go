@@ -0,0 +1,252 @@
+package producer
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+)
+
+const (
+	ProofTypeRISC0 = "risc0"
+
+	// The statuses of a zk proof job which is still in progress in Raiko.
+	zkProofStatusRegistered     = "registered"
+	zkProofStatusWorkInProgress = "work_in_progress"
+)
+
+var (
+	// zkProofPollingInterval is the interval between two polls of a zk proof job, which takes way
+	// longer than a SGX proof to be generated.
+	zkProofPollingInterval = 30 * time.Second
+	// defaultRISC0ExecutionPo2 is the RISC0 segment size used by the Bonsai proving service.
+	defaultRISC0ExecutionPo2 = big.NewInt(20)
+
+	bytesType, _   = abi.NewType("bytes", "", nil)
+	bytes32Type, _ = abi.NewType("bytes32", "", nil)
+	// risc0ProofArgs is the layout RiscZeroVerifier decodes a proof with:
+	// abi.encode(seal, imageId, postStateDigest).
+	risc0ProofArgs = abi.Arguments{
+		{Name: "seal", Type: bytesType},
+		{Name: "imageId", Type: bytes32Type},
+		{Name: "postStateDigest", Type: bytes32Type},
+	}
+)
+
+// ZKvmProofProducer generates a RISC0 proof for the given block. The proofs are
+// generated asynchronously by Raiko: the first request registers a proof job, and the following
+// identical requests poll its status until the proof is generated.
+type ZKvmProofProducer struct {
+	RaikoHostEndpoint string // a proverd RPC endpoint
+	ZKProofType       string // ProofTypeRISC0
+	JWT               string // JWT provided by Raiko
+	Dummy             bool
+	DummyProofProducer
+}
+
+// RaikoRequestProofBodyResponseV2 represents the JSON body of the response of the asynchronous
+// proof requests.
+type RaikoRequestProofBodyResponseV2 struct {
+	Data         *RaikoProofDataV2 `json:"data"`
+	ErrorMessage string            `json:"message"`
+	Error        string            `json:"error"`
+}
+
+// RaikoProofDataV2 is either the status of a proof job in progress, or the generated proof.
+type RaikoProofDataV2 struct {
+	Status string `json:"status"`
+	Proof  string `json:"proof"` //nolint:revive,stylecheck
+}
+
+// RequestProof implements the ProofProducer interface.
+func (z *ZKvmProofProducer) RequestProof(
+	ctx context.Context,
+	opts *ProofRequestOptions,
+	blockID *big.Int,
+	meta *bindings.TaikoDataBlockMetadata,
+	header *types.Header,
+) (*ProofWithHeader, error) {
+	log.Info(
+		"Request zk proof from raiko-host service",
+		"blockID", blockID,
+		"coinbase", meta.Coinbase,
+		"height", header.Number,
+		"hash", header.Hash(),
+		"zkProofType", z.ZKProofType,
+	)
+
+	if z.Dummy {
+		return z.DummyProofProducer.RequestProof(opts, blockID, meta, header, z.Tier())
+	}
+
+	proof, err := z.callProverDaemon(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.ProverZKvmProofGeneratedCounter.Add(1)
+
+	return &ProofWithHeader{
+		BlockID: blockID,
+		Header:  header,
+		Meta:    meta,
+		Proof:   proof,
+		Opts:    opts,
+		Tier:    z.Tier(),
+	}, nil
+}
+
+// callProverDaemon keeps polling the proverd service until the requested zk proof is generated.
+func (z *ZKvmProofProducer) callProverDaemon(ctx context.Context, opts *ProofRequestOptions) ([]byte, error) {
+	var (
+		proof []byte
+		start = time.Now()
+	)
+	if err := backoff.Retry(func() error {
+		if ctx.Err() != nil {
+			return nil
+		}
+		output, err := z.requestProof(ctx, opts)
+		if err != nil {
+			log.Error(
+				"Failed to request zk proof",
+				"height", opts.BlockID,
+				"error", err,
+				"endpoint", z.RaikoHostEndpoint,
+			)
+			return err
+		}
+
+		if output.Data.Proof == "" {
+			switch output.Data.Status {
+			case zkProofStatusRegistered, zkProofStatusWorkInProgress:
+				log.Info(
+					"Zk proof generating",
+					"height", opts.BlockID,
+					"status", output.Data.Status,
+					"time", time.Since(start),
+					"producer", "ZKvmProofProducer",
+				)
+				return errProofGenerating
+			default:
+				// The proof job has been cancelled or has failed, so it is not worth polling anymore.
+				return backoff.Permanent(fmt.Errorf("zk proof job failed, status: %s", output.Data.Status))
+			}
+		}
+
+		proof = common.FromHex(output.Data.Proof)
+
+		// The proof is submitted as is, so it must already be in the layout the verifier decodes.
+		if _, err := risc0ProofArgs.Unpack(proof); err != nil {
+			return backoff.Permanent(
+				fmt.Errorf("invalid RISC0 proof, expected abi.encode(seal, imageId, postStateDigest): %w", err),
+			)
+		}
+
+		log.Info(
+			"Zk proof generated",
+			"height", opts.BlockID,
+			"time", time.Since(start),
+			"producer", "ZKvmProofProducer",
+		)
+		return nil
+	}, backoff.WithContext(backoff.NewConstantBackOff(zkProofPollingInterval), ctx)); err != nil {
+		return nil, err
+	}
+	if proof == nil {
+		return nil, errProofRequestCanceled
+	}
+
+	return proof, nil
+}
+
+// requestProof sends a RPC request to proverd to register the zk proof job, or to get its status.
+func (z *ZKvmProofProducer) requestProof(
+	ctx context.Context,
+	opts *ProofRequestOptions,
+) (*RaikoRequestProofBodyResponseV2, error) {
+	reqBody := RaikoRequestProofBody{
+		Type:     z.ZKProofType,
+		Block:    opts.BlockID,
+		Prover:   opts.ProverAddress.Hex()[2:],
+		Graffiti: opts.Graffiti,
+		RISC0: RISC0RequestProofBodyParam{
+			Bonsai:       true,
+			Snark:        true,
+			Profile:      false,
+			ExecutionPo2: defaultRISC0ExecutionPo2,
+		},
+	}
+
+	jsonValue, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", z.RaikoHostEndpoint+"/v2/proof", bytes.NewBuffer(jsonValue))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(z.JWT) > 0 {
+		req.Header.Set("Authorization", "Bearer "+base64.StdEncoding.EncodeToString([]byte(z.JWT)))
+	}
+
+	res, err := new(http.Client).Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to request zk proof, id: %d, statusCode: %d", opts.BlockID, res.StatusCode)
+	}
+
+	resBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var output RaikoRequestProofBodyResponseV2
+	if err := json.Unmarshal(resBytes, &output); err != nil {
+		return nil, err
+	}
+
+	if len(output.Error) > 0 || len(output.ErrorMessage) > 0 {
+		return nil, fmt.Errorf(
+			"failed to get zk proof, error: %s, msg: %s",
+			output.Error,
+			strings.TrimSpace(output.ErrorMessage),
+		)
+	}
+	if output.Data == nil {
+		return nil, errors.New("failed to get zk proof, empty response data")
+	}
+
+	return &output, nil
+}
+
+// Tier implements the ProofProducer interface. TierProviderBase resolves the verifier of the
+// SGX + zkVM tier as `tier_sgx_zkvm`. No verifier in the protocol combines an SGX and a zkVM proof,
+// so that address is a RiscZeroVerifier, which verifies the bare RISC0 proof in the
+// abi.encode(seal, imageId, postStateDigest) layout checked when the proof is generated.
+func (z *ZKvmProofProducer) Tier() uint16 {
+	return encoding.TierSgxAndZkVMID
+}
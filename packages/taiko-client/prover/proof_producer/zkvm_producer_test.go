@@ -0,0 +1,120 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+)
+
+// newStubRaikoServer starts a Raiko stub which reports the given statuses for a proof job, one per
+// request, before returning the proof.
+func newStubRaikoServer(t *testing.T, proof string, statuses ...string) (*httptest.Server, *atomic.Int32) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v2/proof", r.URL.Path)
+
+		var body RaikoRequestProofBody
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, ProofTypeRISC0, body.Type)
+		require.True(t, body.RISC0.Bonsai)
+
+		res := &RaikoRequestProofBodyResponseV2{Data: &RaikoProofDataV2{Proof: proof}}
+		if i := int(requests.Add(1)) - 1; i < len(statuses) {
+			res.Data = &RaikoProofDataV2{Status: statuses[i]}
+		}
+		require.Nil(t, json.NewEncoder(w).Encode(res))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &requests
+}
+
+func requestTestZKProof(ctx context.Context, producer *ZKvmProofProducer) (*ProofWithHeader, error) {
+	return producer.RequestProof(
+		ctx,
+		&ProofRequestOptions{BlockID: common.Big1},
+		common.Big1,
+		&bindings.TaikoDataBlockMetadata{},
+		&types.Header{Number: common.Big1},
+	)
+}
+
+func TestZKvmProducerRequestProof(t *testing.T) {
+	defer func(interval time.Duration) { zkProofPollingInterval = interval }(zkProofPollingInterval)
+	zkProofPollingInterval = time.Millisecond
+
+	proof, err := risc0ProofArgs.Pack([]byte{1, 2, 3}, common.HexToHash("0x1"), common.HexToHash("0x2"))
+	require.Nil(t, err)
+
+	srv, requests := newStubRaikoServer(
+		t,
+		common.Bytes2Hex(proof),
+		zkProofStatusRegistered,
+		zkProofStatusWorkInProgress,
+		zkProofStatusWorkInProgress,
+	)
+
+	res, err := requestTestZKProof(
+		context.Background(),
+		&ZKvmProofProducer{RaikoHostEndpoint: srv.URL, ZKProofType: ProofTypeRISC0},
+	)
+	require.Nil(t, err)
+	require.Equal(t, proof, res.Proof)
+	require.Equal(t, encoding.TierSgxAndZkVMID, res.Tier)
+	require.Equal(t, int32(4), requests.Load())
+}
+
+func TestZKvmProducerInvalidProof(t *testing.T) {
+	srv, requests := newStubRaikoServer(t, "0x010203")
+
+	// A proof the verifier could not decode is not worth polling for again.
+	_, err := requestTestZKProof(
+		context.Background(),
+		&ZKvmProofProducer{RaikoHostEndpoint: srv.URL, ZKProofType: ProofTypeRISC0},
+	)
+	require.ErrorContains(t, err, "invalid RISC0 proof")
+	require.Equal(t, int32(1), requests.Load())
+}
+
+func TestZKvmProducerJobFailed(t *testing.T) {
+	defer func(interval time.Duration) { zkProofPollingInterval = interval }(zkProofPollingInterval)
+	zkProofPollingInterval = time.Millisecond
+
+	srv, requests := newStubRaikoServer(t, "0x010203", zkProofStatusRegistered, "cancelled")
+
+	_, err := requestTestZKProof(
+		context.Background(),
+		&ZKvmProofProducer{RaikoHostEndpoint: srv.URL, ZKProofType: ProofTypeRISC0},
+	)
+	require.ErrorContains(t, err, "cancelled")
+	require.Equal(t, int32(2), requests.Load())
+}
+
+func TestZKvmProducerCanceled(t *testing.T) {
+	srv, _ := newStubRaikoServer(t, "0x010203", zkProofStatusRegistered, zkProofStatusWorkInProgress)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	// The proof job is still in progress when the context is done.
+	_, err := requestTestZKProof(ctx, &ZKvmProofProducer{RaikoHostEndpoint: srv.URL, ZKProofType: ProofTypeRISC0})
+	require.NotNil(t, err)
+}
+
+func TestZKvmProducerDummy(t *testing.T) {
+	res, err := requestTestZKProof(context.Background(), &ZKvmProofProducer{Dummy: true})
+	require.Nil(t, err)
+	require.Equal(t, encoding.TierSgxAndZkVMID, res.Tier)
+	require.NotEmpty(t, res.Proof)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
 	handler "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/event_handler"
 	guardianProverHeartbeater "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/guardian_prover_heartbeater"
+	jobStore "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/job_store"
 	proofProducer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
 	proofSubmitter "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_submitter"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_submitter/transaction"
@@ -53,6 +54,11 @@ type Prover struct {
 	sharedState     *state.SharedState
 	genesisHeightL1 uint64
 
+	// Proof jobs, and the blocks whose proofs are being generated or submitted by this process.
+	jobStore         *jobStore.JobStore
+	generatingProofs sync.Map
+	submittingProofs sync.Map
+
 	// Event handlers
 	blockProposedHandler       handler.BlockProposedHandler
 	blockVerifiedHandler       handler.BlockVerifiedHandler
@@ -95,6 +101,9 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config, txMgr *txmgr.Si
 	p.ctx = ctx
 	// Initialize state which will be shared by event handlers.
 	p.sharedState = state.New()
+	if p.jobStore, err = jobStore.New(cfg.JobStorePath); err != nil {
+		return fmt.Errorf("failed to open proof job store: %w", err)
+	}
 	p.backoff = backoff.WithContext(
 		backoff.WithMaxRetries(
 			backoff.NewConstantBackOff(p.cfg.BackOffRetryInterval),
@@ -265,6 +274,15 @@ func (p *Prover) Start() error {
 	// 4. Start the main event loop of the prover.
 	go p.eventLoop()
 
+	// 5. Resume the proof jobs left unfinished by the last run.
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		if err := p.resumeProofJobs(); err != nil {
+			log.Error("Failed to resume proof jobs", "error", err)
+		}
+	}()
+
 	return nil
 }
 
@@ -315,6 +333,9 @@ func (p *Prover) eventLoop() {
 		case req := <-p.proofContestCh:
 			p.withRetry(func() error { return p.contestProofOp(req) })
 		case proofWithHeader := <-p.proofGenerationCh:
+			if err := p.jobStore.MarkGenerated(proofWithHeader); err != nil {
+				log.Error("Failed to store generated proof", "blockID", proofWithHeader.BlockID, "error", err)
+			}
 			p.withRetry(func() error { return p.submitProofOp(proofWithHeader) })
 		case req := <-p.proofSubmissionCh:
 			p.withRetry(func() error { return p.requestProofOp(req.Event, req.Tier) })
@@ -324,7 +345,15 @@ func (p *Prover) eventLoop() {
 			}
 		case e := <-blockVerifiedCh:
 			p.blockVerifiedHandler.Handle(e)
+			if err := p.jobStore.Prune(e.BlockId); err != nil {
+				log.Error("Failed to prune proof jobs", "blockID", e.BlockId, "error", err)
+			}
 		case e := <-transitionProvedCh:
+			if e.Prover == p.ProverAddress() || e.Prover == p.cfg.ProverSetAddress {
+				if err := p.jobStore.MarkConfirmed(e.BlockId, e.Raw.TxHash); err != nil {
+					log.Error("Failed to store confirmed proof", "blockID", e.BlockId, "error", err)
+				}
+			}
 			p.withRetry(func() error { return p.transitionProvedHandler.Handle(p.ctx, e) })
 		case e := <-transitionContestedCh:
 			p.withRetry(func() error { return p.transitionContestedHandler.Handle(p.ctx, e) })
@@ -344,6 +373,9 @@ func (p *Prover) Close(ctx context.Context) {
 		log.Error("Failed to shut down prover server", "error", err)
 	}
 	p.wg.Wait()
+	if err := p.jobStore.Close(); err != nil {
+		log.Error("Failed to close proof job store", "error", err)
+	}
 }
 
 // proveOp iterates through BlockProposed events.
@@ -404,8 +436,38 @@ func (p *Prover) requestProofOp(e *bindings.TaikoL1ClientBlockProposed, minTier
 		}
 	}
 	if submitter := p.selectSubmitter(minTier); submitter != nil {
+		// Skip the blocks whose proofs are already being generated by this process.
+		if _, loaded := p.generatingProofs.LoadOrStore(e.BlockId.Uint64(), struct{}{}); loaded {
+			log.Info("Proof is being generated, skip requesting", "blockID", e.BlockId)
+			return nil
+		}
+		defer p.generatingProofs.Delete(e.BlockId.Uint64())
+
+		job, err := p.jobStore.MarkRequested(&proofProducer.ProofRequestBody{Tier: submitter.Tier(), Event: e})
+		if err != nil {
+			return fmt.Errorf("failed to store proof request: %w", err)
+		}
+
+		// Never prove a block twice.
+		if job.Status == jobStore.StatusConfirmed {
+			log.Info("Proof has already landed, skip requesting", "blockID", e.BlockId, "tier", job.Tier)
+			return nil
+		}
+		if proofWithHeader := job.ProofWithHeader(); proofWithHeader != nil {
+			log.Info("Proof has already been generated, submit it", "blockID", e.BlockId, "tier", job.Tier)
+			p.proofGenerationCh <- proofWithHeader
+			return nil
+		}
+
+		if err := p.jobStore.MarkGenerating(e.BlockId); err != nil {
+			return fmt.Errorf("failed to store proof generation: %w", err)
+		}
+
 		if err := submitter.RequestProof(p.ctx, e); err != nil {
 			log.Error("Request new proof error", "blockID", e.BlockId, "minTier", e.Meta.MinTier, "error", err)
+			if err := p.jobStore.MarkGenerationFailed(e.BlockId); err != nil {
+				log.Error("Failed to store proof generation failure", "blockID", e.BlockId, "error", err)
+			}
 			return err
 		}
 
@@ -423,6 +485,17 @@ func (p *Prover) submitProofOp(proofWithHeader *proofProducer.ProofWithHeader) e
 		return nil
 	}
 
+	// Skip the proofs which are already being submitted by this process.
+	if _, loaded := p.submittingProofs.LoadOrStore(proofWithHeader.BlockID.Uint64(), struct{}{}); loaded {
+		log.Info("Proof is being submitted, skip submitting", "blockID", proofWithHeader.BlockID)
+		return nil
+	}
+	defer p.submittingProofs.Delete(proofWithHeader.BlockID.Uint64())
+
+	if err := p.jobStore.MarkSubmitted(proofWithHeader.BlockID); err != nil {
+		return fmt.Errorf("failed to store proof submission: %w", err)
+	}
+
 	if err := submitter.SubmitProof(p.ctx, proofWithHeader); err != nil {
 		if strings.Contains(err.Error(), vm.ErrExecutionReverted.Error()) {
 			log.Error(
@@ -445,6 +518,55 @@ func (p *Prover) submitProofOp(proofWithHeader *proofProducer.ProofWithHeader) e
 	return nil
 }
 
+// resumeProofJobs resumes the proof jobs which have not landed on chain yet, the generated proofs
+// are submitted directly, and the others are requested again.
+func (p *Prover) resumeProofJobs() error {
+	jobs, err := p.jobStore.Pending()
+	if err != nil {
+		return err
+	}
+
+	for _, job := range jobs {
+		// Check whether the block still needs a proof.
+		proofStatus, err := rpc.GetBlockProofStatus(
+			p.ctx,
+			p.rpc,
+			job.BlockID,
+			p.ProverAddress(),
+			p.cfg.ProverSetAddress,
+		)
+		if err != nil {
+			return err
+		}
+		if proofStatus.IsSubmitted && !proofStatus.Invalid {
+			log.Info("Proof has already landed, skip resuming", "blockID", job.BlockID, "status", job.Status)
+			if err := p.jobStore.MarkConfirmed(job.BlockID, common.Hash{}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		log.Info("Resume proof job", "blockID", job.BlockID, "tier", job.Tier, "status", job.Status)
+
+		if proofWithHeader := job.ProofWithHeader(); proofWithHeader != nil {
+			select {
+			case <-p.ctx.Done():
+				return p.ctx.Err()
+			case p.proofGenerationCh <- proofWithHeader:
+			}
+			continue
+		}
+
+		select {
+		case <-p.ctx.Done():
+			return p.ctx.Err()
+		case p.proofSubmissionCh <- &proofProducer.ProofRequestBody{Tier: job.Tier, Event: job.Event}:
+		}
+	}
+
+	return nil
+}
+
 // Name returns the application name.
 func (p *Prover) Name() string {
 	return "prover"
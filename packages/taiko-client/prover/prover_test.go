@@ -114,6 +114,7 @@ func (s *ProverTestSuite) SetupTest() {
 		ProverEndpoints:            []*url.URL{proverServerURL},
 		OptimisticTierFee:          common.Big256,
 		SgxTierFee:                 common.Big256,
+		SgxAndZkVMTierFee:          common.Big256,
 		MaxTierFeePriceBumps:       3,
 		TierFeePriceBump:           common.Big2,
 		L1BlockBuilderTip:          common.Big0,